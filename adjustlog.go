@@ -0,0 +1,106 @@
+package tai
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AdjustmentKind is the category of an Adjustment
+type AdjustmentKind int
+
+const (
+	// Step is a discontinuous change of phase, made by a Discipline when the
+	// measured offset exceeds its StepThreshold
+	Step AdjustmentKind = iota + 1
+	// Steer is a gradual correction of phase and frequency made by a
+	// Discipline
+	Steer
+	// Clamped is a stamp moved forward by a Monotonicizer with the Clamp
+	// policy, or released by Flush
+	Clamped
+	// Interpolated is a stamp respaced by a Monotonicizer with the
+	// Interpolate policy
+	Interpolated
+)
+
+var adjustmentKindNames = [...]string{"", "Step", "Steer", "Clamped", "Interpolated"}
+
+// String returns the name of k, e.g. Steer
+func (k AdjustmentKind) String() string {
+	if k < Step || k > Interpolated {
+		return fmt.Sprintf("AdjustmentKind(%d)", int(k))
+	}
+	return adjustmentKindNames[k]
+}
+
+// Adjustment is a correction applied to a clock or a stream of timestamps
+type Adjustment struct {
+	Kind AdjustmentKind
+	// At is the TAI moment of the adjustment: the reference time of the
+	// observation for a Discipline, and the output stamp for a Monotonicizer
+	At TAI
+	// Phase is the change of time made, corrected less uncorrected
+	Phase Duration
+	// Frequency is the change of the fractional frequency estimate made by a
+	// Steer
+	Frequency float64
+}
+
+// AdjustmentLog records the Adjustments made by the Disciplines and
+// Monotonicizers it is attached to, keeping the most recent, for post-mortem
+// analysis of timing anomalies.  AdjustmentLog is safe for concurrent use.
+type AdjustmentLog struct {
+	mu   sync.Mutex
+	ring []Adjustment
+	next int
+	full bool
+}
+
+// NewAdjustmentLog returns an AdjustmentLog that keeps the most recent
+// capacity adjustments.
+//
+// NewAdjustmentLog panics if capacity is less than one.
+func NewAdjustmentLog(capacity int) *AdjustmentLog {
+	if capacity < 1 {
+		panic("tai.NewAdjustmentLog: capacity must be at least one")
+	}
+	return &AdjustmentLog{ring: make([]Adjustment, capacity)}
+}
+
+// record appends a to the log; a nil log records nothing
+func (l *AdjustmentLog) record(a Adjustment) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.ring[l.next] = a
+	l.next++
+	if l.next == len(l.ring) {
+		l.next = 0
+		l.full = true
+	}
+}
+
+// Records returns a copy of the log, in the order the adjustments were made
+func (l *AdjustmentLog) Records() []Adjustment {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var out []Adjustment
+	if l.full {
+		out = append(out, l.ring[l.next:]...)
+	}
+	return append(out, l.ring[:l.next]...)
+}
+
+// Between returns the adjustments whose At is within iv, in the order they
+// were made
+func (l *AdjustmentLog) Between(iv Interval) []Adjustment {
+	var out []Adjustment
+	for _, a := range l.Records() {
+		if iv.Contains(a.At) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
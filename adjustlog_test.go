@@ -0,0 +1,57 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestAdjustmentLogMonotonicizer(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	at := func(ms int64) tai.TAI { return base.Add(0, ms*tai.Millisecond) }
+	log := tai.NewAdjustmentLog(8)
+	m := tai.Monotonicizer{Policy: tai.Clamp, MinStep: tai.Dur(0, tai.Millisecond), Log: log}
+	pushAll(t, &m, []tai.TAI{at(0), at(10), at(5)})
+	m = tai.Monotonicizer{Policy: tai.Interpolate, MinStep: tai.Dur(0, tai.Millisecond), Log: log}
+	pushAll(t, &m, []tai.TAI{at(100), at(90), at(130), at(120)})
+	exp := []tai.Adjustment{
+		{Kind: tai.Clamped, At: at(11), Phase: tai.Dur(0, 6*tai.Millisecond)},
+		{Kind: tai.Interpolated, At: at(115), Phase: tai.Dur(0, 25*tai.Millisecond)},
+		{Kind: tai.Clamped, At: at(131), Phase: tai.Dur(0, 11*tai.Millisecond)},
+	}
+	got := log.Records()
+	if len(got) != len(exp) {
+		t.Fatalf("expected %d records, got %d: %+v", len(exp), len(got), got)
+	}
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Errorf("record %d: expected %+v, got %+v", i, exp[i], got[i])
+		}
+	}
+	if b := log.Between(tai.Interval{Start: at(100), End: at(200)}); len(b) != 2 {
+		t.Errorf("expected 2 records between 100 and 200 ms, got %d", len(b))
+	}
+}
+
+func TestAdjustmentLogDiscipline(t *testing.T) {
+	log := tai.NewAdjustmentLog(2)
+	d := &tai.Discipline{StepThreshold: tai.Dur(0, 128*tai.Millisecond), Log: log}
+	origin := tai.Date(2024, 7, 1)
+	d.Update(origin, origin)
+	d.Update(origin.Add(1, 0), origin.Add(1, tai.Millisecond))
+	d.Update(origin.Add(2, 0), origin.Add(4, 0))
+	got := log.Records()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(got))
+	}
+	if got[0].Kind != tai.Steer || !got[0].Phase.Eq(tai.Dur(0, tai.Millisecond/2)) || got[0].Frequency <= 0 {
+		t.Errorf("expected a steer of half the 1 ms offset, got %+v", got[0])
+	}
+	if got[1].Kind != tai.Step || !got[1].At.Eq(origin.Add(4, 0)) {
+		t.Errorf("expected a step at %+v, got %+v", origin.Add(4, 0), got[1])
+	}
+	d.Update(origin.Add(3, 0), origin.Add(5, 0))
+	if got := log.Records(); len(got) != 2 || got[0].Kind != tai.Step {
+		t.Errorf("expected the oldest record to be evicted, got %+v", got)
+	}
+}
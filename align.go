@@ -0,0 +1,111 @@
+package tai
+
+// Grid is a regular series of instants, Origin + k*Step for every integer k
+type Grid struct {
+	Origin TAI
+	Step   Duration
+}
+
+// Index returns the index of the last grid point at or before t
+//
+// Index panics if g.Step is zero.
+func (g Grid) Index(t TAI) int64 {
+	k, _ := divmod(sub(t, g.Origin), g.Step)
+	return k
+}
+
+// At returns the k-th grid point
+func (g Grid) At(k int64) TAI {
+	return g.Origin.AddDuration(g.Step.Mul(k))
+}
+
+// Floor returns the last grid point at or before t
+func (g Grid) Floor(t TAI) TAI {
+	return g.At(g.Index(t))
+}
+
+// Ceil returns the first grid point at or after t
+func (g Grid) Ceil(t TAI) TAI {
+	k, r := divmod(sub(t, g.Origin), g.Step)
+	if r != (Duration{}) {
+		k++
+	}
+	return g.At(k)
+}
+
+// Nearest returns the grid point closest to t; halfway cases round toward
+// the future
+func (g Grid) Nearest(t TAI) TAI {
+	k, r := divmod(sub(t, g.Origin), g.Step)
+	if !r.Mul(2).Less(g.Step) {
+		k++
+	}
+	return g.At(k)
+}
+
+// Sample is a value observed at an instant
+type Sample struct {
+	T TAI
+	V float64
+}
+
+// AlignMethod selects how Align computes the value at each grid point
+type AlignMethod int
+
+const (
+	// AlignFloor uses the value of the last sample at or before the grid
+	// point (sample and hold)
+	AlignFloor AlignMethod = iota
+	// AlignNearest uses the value of the sample closest in time to the grid
+	// point; ties prefer the earlier sample
+	AlignNearest
+	// AlignLinear linearly interpolates between the samples on either side of
+	// the grid point
+	AlignLinear
+)
+
+// Align resamples a series of samples onto the points of a grid, producing one
+// output sample for each grid point between the first and last input samples,
+// inclusive.
+//
+// samples must be sorted in chronological order.  Grid arithmetic is exact;
+// only interpolated values are subject to floating point rounding.
+//
+// Align panics if g.Step is not positive.
+func Align(samples []Sample, g Grid, method AlignMethod) []Sample {
+	if !(Duration{}).Less(g.Step) {
+		panic("tai.Align: grid step must be positive")
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+	first, last := samples[0].T, samples[len(samples)-1].T
+	k := g.Index(first)
+	if g.At(k).Before(first) {
+		k++
+	}
+	var out []Sample
+	i := 0 // samples[i] is the last sample at or before the grid point
+	for gp := g.At(k); !gp.After(last); gp = g.At(k) {
+		for i+1 < len(samples) && !samples[i+1].T.After(gp) {
+			i++
+		}
+		s := samples[i]
+		v := s.V
+		if i+1 < len(samples) && !s.T.Eq(gp) {
+			next := samples[i+1]
+			switch method {
+			case AlignNearest:
+				if sub(next.T, gp).Less(sub(gp, s.T)) {
+					v = next.V
+				}
+			case AlignLinear:
+				frac := sub(gp, s.T).Seconds() / sub(next.T, s.T).Seconds()
+				v = s.V + frac*(next.V-s.V)
+			}
+		}
+		out = append(out, Sample{T: gp, V: v})
+		k++
+	}
+	return out
+}
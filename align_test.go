@@ -0,0 +1,94 @@
+package tai_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestGridRounding(t *testing.T) {
+	origin := tai.Date(2024, 7, 1)
+	g := tai.Grid{Origin: origin, Step: tai.Dur(0, 10*tai.Millisecond)}
+	inp := origin.Add(0, 25*tai.Millisecond+1)
+	cases := []struct {
+		descr string
+		got   tai.TAI
+		exp   tai.TAI
+	}{
+		{"Floor", g.Floor(inp), origin.Add(0, 20*tai.Millisecond)},
+		{"Ceil", g.Ceil(inp), origin.Add(0, 30*tai.Millisecond)},
+		{"Nearest", g.Nearest(inp), origin.Add(0, 30*tai.Millisecond)},
+		{"NearestBelow", g.Nearest(origin.Add(0, 25*tai.Millisecond-1)), origin.Add(0, 20*tai.Millisecond)},
+		{"FloorBeforeOrigin", g.Floor(origin.Add(0, -1)), origin.Add(0, -10*tai.Millisecond)},
+		{"CeilOnGrid", g.Ceil(origin), origin},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if !tc.got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, tc.got)
+			}
+		})
+	}
+}
+
+func TestGridLongStepIsExact(t *testing.T) {
+	origin := tai.Date(2000, 1, 1)
+	g := tai.Grid{Origin: origin, Step: tai.Dur(86400, 1)}
+	k := int64(10000)
+	if got := g.Index(g.At(k)); got != k {
+		t.Fatalf("expected index %d, got %d", k, got)
+	}
+	if got := g.Index(g.At(k).Add(0, -1)); got != k-1 {
+		t.Fatalf("expected index %d, got %d", k-1, got)
+	}
+}
+
+func TestAlign(t *testing.T) {
+	origin := tai.Date(2024, 7, 1)
+	samples := []tai.Sample{
+		{T: origin.Add(0, 5*tai.Millisecond), V: 0},
+		{T: origin.Add(0, 12*tai.Millisecond), V: 7},
+		{T: origin.Add(0, 31*tai.Millisecond), V: 26},
+	}
+	g := tai.Grid{Origin: origin, Step: tai.Dur(0, 10*tai.Millisecond)}
+	cases := []struct {
+		descr  string
+		method tai.AlignMethod
+		exp    []float64
+	}{
+		{"Floor", tai.AlignFloor, []float64{0, 7, 7}},
+		{"Nearest", tai.AlignNearest, []float64{7, 7, 26}},
+		{"Linear", tai.AlignLinear, []float64{5, 15, 25}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			out := tai.Align(samples, g, tc.method)
+			if len(out) != len(tc.exp) {
+				t.Fatalf("expected %d samples, got %d", len(tc.exp), len(out))
+			}
+			for i, s := range out {
+				if !s.T.Eq(g.At(int64(i + 1))) {
+					t.Errorf("sample %d not on grid point %d", i, i+1)
+				}
+				if math.Abs(s.V-tc.exp[i]) > 1e-9 {
+					t.Errorf("sample %d: expected %v, got %v", i, tc.exp[i], s.V)
+				}
+			}
+		})
+	}
+}
+
+func TestAlignInvalidStep(t *testing.T) {
+	samples := []tai.Sample{{T: tai.Date(2024, 7, 1)}, {T: tai.Date(2024, 7, 2)}}
+	for _, step := range []tai.Duration{{}, tai.Dur(-1, 0)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for the step %+v", step)
+				}
+			}()
+			tai.Align(samples, tai.Grid{Step: step}, tai.AlignFloor)
+		}()
+	}
+}
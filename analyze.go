@@ -0,0 +1,108 @@
+package tai
+
+import (
+	"fmt"
+	"sort"
+)
+
+// FindingKind is the category of a Finding
+type FindingKind int
+
+const (
+	// Gap is a span between chronologically successive stamps that exceeds
+	// the gap threshold
+	Gap FindingKind = iota + 1
+	// OutOfOrder is a run of successive stamps that are each before the
+	// latest stamp preceding the run
+	OutOfOrder
+	// Duplicate is a stamp equal to an earlier stamp
+	Duplicate
+)
+
+var findingKindNames = [...]string{"", "Gap", "OutOfOrder", "Duplicate"}
+
+// String returns the name of k, e.g. Gap
+func (k FindingKind) String() string {
+	if k < Gap || k > Duplicate {
+		return fmt.Sprintf("FindingKind(%d)", int(k))
+	}
+	return findingKindNames[k]
+}
+
+// Finding is an irregularity in a stream of timestamps, reported by
+// AnalyzeStream.  The meaning of the fields depends on the Kind:
+//
+// - Gap: Start and End are the chronologically successive stamps bounding the
+// gap, and Index is the position of End in the input
+//
+// - OutOfOrder: Index is the position of the first stamp of the run in the
+// input and Count is the length of the run.  Start is the earliest stamp in
+// the run and End is the latest stamp preceding it.
+//
+// - Duplicate: Index is the position of the repeated stamp and Count is the
+// position of its first occurrence.  Start and End are the stamp.
+type Finding struct {
+	Kind  FindingKind
+	Index int
+	Count int
+	Start TAI
+	End   TAI
+}
+
+// Length returns End - Start, the size of a gap or the maximum regression of
+// an out of order run
+func (f Finding) Length() Duration {
+	return sub(f.End, f.Start)
+}
+
+// AnalyzeStream scans a stream of timestamps in the order given and reports
+// duplicates, out of order runs, and gaps longer than maxGap.  Gaps are found
+// in chronological order, so the stream need not be sorted.  A zero maxGap
+// disables gap detection.
+//
+// findings are returned sorted by Index.
+func AnalyzeStream(ts []TAI, maxGap Duration) []Finding {
+	var out []Finding
+	if len(ts) == 0 {
+		return out
+	}
+	seen := make(map[TAI]int, len(ts))
+	latest := ts[0]
+	run := -1 // index of the current out of order run, if any
+	for i, t := range ts {
+		if first, ok := seen[t]; ok {
+			out = append(out, Finding{Kind: Duplicate, Index: i, Count: first, Start: t, End: t})
+		} else {
+			seen[t] = i
+		}
+		if t.Before(latest) {
+			if run < 0 {
+				out = append(out, Finding{Kind: OutOfOrder, Index: i, Start: t, End: latest})
+				run = len(out) - 1
+			}
+			f := &out[run]
+			f.Count++
+			if t.Before(f.Start) {
+				f.Start = t
+			}
+			continue
+		}
+		run = -1
+		latest = t
+	}
+	if maxGap != (Duration{}) {
+		idx := make([]int, len(ts))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(a, b int) bool { return ts[idx[a]].Before(ts[idx[b]]) })
+		for k := 1; k < len(idx); k++ {
+			prev, next := ts[idx[k-1]], ts[idx[k]]
+			if maxGap.Less(sub(next, prev)) {
+				out = append(out, Finding{Kind: Gap, Index: idx[k], Start: prev, End: next})
+			}
+		}
+	}
+	sort.SliceStable(out, func(a, b int) bool { return out[a].Index < out[b].Index })
+	return out
+}
@@ -0,0 +1,48 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestAnalyzeStream(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	at := func(s int) tai.TAI { return base.AddHMS(0, 0, s) }
+	ts := []tai.TAI{at(0), at(1), at(2), at(1), at(0), at(3), at(3), at(10), at(11)}
+	got := tai.AnalyzeStream(ts, tai.Dur(5, 0))
+	exp := []tai.Finding{
+		{Kind: tai.Duplicate, Index: 3, Count: 1, Start: at(1), End: at(1)},
+		{Kind: tai.OutOfOrder, Index: 3, Count: 2, Start: at(0), End: at(2)},
+		{Kind: tai.Duplicate, Index: 4, Count: 0, Start: at(0), End: at(0)},
+		{Kind: tai.Duplicate, Index: 6, Count: 5, Start: at(3), End: at(3)},
+		{Kind: tai.Gap, Index: 7, Start: at(3), End: at(10)},
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("expected %d findings, got %d: %+v", len(exp), len(got), got)
+	}
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Errorf("finding %d: expected %+v, got %+v", i, exp[i], got[i])
+		}
+	}
+	if l := got[4].Length(); !l.Eq(tai.Dur(7, 0)) {
+		t.Errorf("expected gap length of 7 s, got %+v", l)
+	}
+}
+
+func TestAnalyzeStreamUnsortedGaps(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	ts := []tai.TAI{base.AddHMS(0, 0, 2), base, base.AddHMS(0, 0, 1)}
+	for _, f := range tai.AnalyzeStream(ts, tai.Dur(1, 0)) {
+		if f.Kind == tai.Gap {
+			t.Fatalf("unexpected gap in an unsorted but contiguous stream: %+v", f)
+		}
+	}
+}
+
+func TestAnalyzeStreamEmpty(t *testing.T) {
+	if got := tai.AnalyzeStream(nil, tai.Dur(1, 0)); len(got) != 0 {
+		t.Fatalf("expected no findings, got %+v", got)
+	}
+}
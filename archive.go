@@ -0,0 +1,161 @@
+package tai
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// zipExtTimeID is the header ID of the zip extended timestamp extra field
+const zipExtTimeID = 0x5455
+
+var (
+	// ErrZipTimeRange is returned when a TAI time is outside of the range of a
+	// zip extended timestamp, a signed 32-bit UNIX time, 1901 through 2038
+	ErrZipTimeRange = errors.New("tai: time out of range of a zip extended timestamp")
+	// ErrZipNoTimestamp is returned when zip extra fields do not include an
+	// extended timestamp with a modification time
+	ErrZipNoTimestamp = errors.New("tai: zip extra fields have no extended timestamp")
+)
+
+// PAXTime returns t in the form of the mtime, atime, and ctime records of a
+// PAX tar header: the decimal number of seconds since the UNIX epoch, with as
+// many fractional digits as are needed to represent t exactly, up to 18, e.g.
+// 1719837296.5.  The leap second table is consulted in making the conversion;
+// see func (TAI) Unix.
+func (t TAI) PAXTime() string {
+	secs, asec := t.unix()
+	var b []byte
+	if secs < 0 && asec > 0 {
+		// -1.25 is stored as -2 and 0.75
+		b = append(b, '-')
+		b = strconv.AppendInt(b, -(secs + 1), 10)
+		asec = 1e18 - asec
+	} else {
+		b = strconv.AppendInt(b, secs, 10)
+	}
+	if asec == 0 {
+		return string(b)
+	}
+	digits := 18
+	for asec%10 == 0 {
+		asec /= 10
+		digits--
+	}
+	b = append(b, '.')
+	return string(appendInt(b, asec, digits))
+}
+
+// ParsePAXTime parses a PAX tar header time record, as produced by PAXTime.
+// Fractional digits beyond the 18th are truncated.
+func ParsePAXTime(s string) (TAI, error) {
+	t, err := parseUnixDecimal(s)
+	if err != nil {
+		return TAI{}, fmt.Errorf("ParsePAXTime: %w", err)
+	}
+	return t, nil
+}
+
+// parseUnixDecimal parses a signed decimal number of UNIX seconds; see func
+// parseDecimalSeconds
+func parseUnixDecimal(s string) (TAI, error) {
+	d, err := parseDecimalSeconds(s)
+	if err != nil {
+		return TAI{}, err
+	}
+	return UnixDuration(d), nil
+}
+
+// parseDecimalSeconds parses a signed decimal number of seconds with any
+// number of fractional digits, of which the first 18 are kept
+func parseDecimalSeconds(s string) (Duration, error) {
+	p := parser{s: s}
+	neg := p.peek() == '-'
+	if neg {
+		p.next()
+	}
+	start := p.i
+	var whole int64
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		if whole > (math.MaxInt64-9)/10 {
+			return Duration{}, errors.New("seconds out of range")
+		}
+		whole = whole*10 + int64(c-'0')
+		p.next()
+	}
+	if p.i == start {
+		p.fail("expected digit")
+	}
+	var asec int64
+	if p.err == nil && p.peek() == '.' {
+		p.next()
+		digits := 0
+		for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+			if digits < 18 {
+				asec = asec*10 + int64(c-'0')
+			}
+			digits++
+			p.next()
+		}
+		if digits == 0 {
+			p.fail("expected digit")
+		}
+		for ; digits < 18; digits++ {
+			asec *= 10
+		}
+	}
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return Duration{}, p.err
+	}
+	if neg {
+		whole, asec = -whole, -asec
+	}
+	return Dur(whole, asec), nil
+}
+
+// ZipExtendedTimestamp returns a zip extra field holding t as the modification
+// time of an extended timestamp (header ID 0x5455), as read by archive/zip and
+// Info-ZIP, for a FileHeader's Extra.  The field has a resolution of one
+// second, and t is truncated toward the past.  The leap second table is
+// consulted in making the conversion; see func (TAI) Unix.
+func (t TAI) ZipExtendedTimestamp() ([]byte, error) {
+	secs, _ := t.unix()
+	if secs < math.MinInt32 || secs > math.MaxInt32 {
+		return nil, ErrZipTimeRange
+	}
+	b := make([]byte, 9)
+	binary.LittleEndian.PutUint16(b, zipExtTimeID)
+	binary.LittleEndian.PutUint16(b[2:], 5)
+	b[4] = 1 // the modification time is present
+	binary.LittleEndian.PutUint32(b[5:], uint32(int32(secs)))
+	return b, nil
+}
+
+// FromZipExtra returns the modification time of the extended timestamp among
+// the zip extra fields extra, such as a FileHeader's Extra.  ErrZipNoTimestamp
+// is returned if there is none.
+func FromZipExtra(extra []byte) (TAI, error) {
+	for len(extra) > 0 {
+		if len(extra) < 4 {
+			return TAI{}, errors.New("FromZipExtra: truncated extra field header")
+		}
+		id := binary.LittleEndian.Uint16(extra)
+		size := int(binary.LittleEndian.Uint16(extra[2:]))
+		extra = extra[4:]
+		if size > len(extra) {
+			return TAI{}, errors.New("FromZipExtra: truncated extra field")
+		}
+		field := extra[:size]
+		extra = extra[size:]
+		if id != zipExtTimeID || size < 5 || field[0]&1 == 0 {
+			continue
+		}
+		return Unix(int64(int32(binary.LittleEndian.Uint32(field[1:]))), 0), nil
+	}
+	return TAI{}, ErrZipNoTimestamp
+}
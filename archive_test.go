@@ -0,0 +1,90 @@
+package tai_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestPAXTime(t *testing.T) {
+	cases := []struct {
+		descr string
+		t     tai.TAI
+		exp   string
+	}{
+		{"Whole", tai.Unix(1719837296, 0), "1719837296"},
+		{"Half", tai.Unix(1719837296, 5e8), "1719837296.5"},
+		{"Atto", tai.UnixAsec(1719837296, 1), "1719837296.000000000000000001"},
+		{"Negative", tai.UnixAsec(-2, 75e16), "-1.25"},
+		{"NegativeWhole", tai.Unix(-3, 0), "-3"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.t.PAXTime(); got != tc.exp {
+				t.Fatalf("expected %q, got %q", tc.exp, got)
+			}
+			back, err := tai.ParsePAXTime(tc.exp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !back.Eq(tc.t) {
+				t.Fatalf("expected %+v, got %+v", tc.t, back)
+			}
+		})
+	}
+	got, err := tai.ParsePAXTime("1719837296.1234567890123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.UnixAsec(1719837296, 123456789012345678); !got.Eq(exp) {
+		t.Fatalf("expected digits beyond the 18th to be truncated, got %+v", got)
+	}
+	for _, s := range []string{"", "-", "1.", ".5", "1.5x", "99999999999999999999"} {
+		if _, err := tai.ParsePAXTime(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestZipExtendedTimestamp(t *testing.T) {
+	mtime := tai.Unix(1719837296, 0)
+	extra, err := mtime.Add(0, 5e17).ZipExtendedTimestamp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	if _, err := w.CreateHeader(&zip.FileHeader{Name: "f", Extra: extra}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := r.File[0].FileHeader
+	if !h.Modified.Equal(time.Unix(1719837296, 0)) {
+		t.Fatalf("expected archive/zip to read the extended timestamp, got %v", h.Modified)
+	}
+	got, err := tai.FromZipExtra(h.Extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(mtime) {
+		t.Fatalf("expected %+v, got %+v", mtime, got)
+	}
+	if _, err := tai.FromZipExtra(nil); err != tai.ErrZipNoTimestamp {
+		t.Fatalf("expected ErrZipNoTimestamp, got %v", err)
+	}
+	if _, err := tai.FromZipExtra(extra[:7]); err == nil {
+		t.Fatal("expected an error for a truncated field")
+	}
+	if _, err := tai.Unix(1<<31, 0).ZipExtendedTimestamp(); err != tai.ErrZipTimeRange {
+		t.Fatalf("expected ErrZipTimeRange, got %v", err)
+	}
+}
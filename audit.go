@@ -0,0 +1,119 @@
+package tai
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ConversionDirection is the direction of a conversion between UTC and TAI
+type ConversionDirection int
+
+const (
+	// UTCToTAI is a conversion from UNIX or stdlib time to TAI
+	UTCToTAI ConversionDirection = iota
+	// TAIToUTC is a conversion from TAI to UNIX or stdlib time
+	TAIToUTC
+)
+
+// AuditRecord describes the leap second table lookup of one conversion
+type AuditRecord struct {
+	// Direction is the direction of the conversion
+	Direction ConversionDirection
+	// Lookup is the number of seconds since the UNIX epoch that was looked up
+	// in the table, counted in UTC for UTCToTAI and in TAI for TAIToUTC
+	Lookup int64
+	// Index is the index of the table entry that was applied, or -1 if the
+	// time preceded the table and no skew was applied
+	Index int
+	// Entry is the table entry that was applied
+	Entry LeapSecond
+}
+
+var (
+	auditOn     int32
+	auditWindow int64
+	auditMu     sync.Mutex
+	auditRing   []AuditRecord
+	auditNext   int
+	auditFull   bool
+)
+
+// EnableAudit turns on the conversion audit trail.  Every conversion between
+// UTC and TAI within window seconds of a leap second is recorded, keeping the
+// most recent capacity records.  Enabling the audit trail clears any existing
+// records.
+//
+// the audit trail adds a small cost to conversions near leaps, and none
+// elsewhere; it is intended to allow post-incident analysis to prove how
+// ambiguous instants were interpreted.
+//
+// EnableAudit panics if capacity is less than one.
+func EnableAudit(window int64, capacity int) {
+	if capacity < 1 {
+		panic("tai.EnableAudit: capacity must be at least one")
+	}
+	auditMu.Lock()
+	defer auditMu.Unlock()
+	auditRing = make([]AuditRecord, capacity)
+	auditNext = 0
+	auditFull = false
+	atomic.StoreInt64(&auditWindow, window)
+	atomic.StoreInt32(&auditOn, 1)
+}
+
+// DisableAudit turns off the conversion audit trail.  Existing records remain
+// available from AuditRecords.
+func DisableAudit() {
+	atomic.StoreInt32(&auditOn, 0)
+}
+
+// AuditRecords returns a copy of the audit trail, oldest first
+func AuditRecords() []AuditRecord {
+	auditMu.Lock()
+	defer auditMu.Unlock()
+	var out []AuditRecord
+	if auditFull {
+		out = append(out, auditRing[auditNext:]...)
+	}
+	return append(out, auditRing[:auditNext]...)
+}
+
+func auditing() bool {
+	return atomic.LoadInt32(&auditOn) != 0
+}
+
+// audit records a lookup of s which applied leaps[i] if it is near a leap;
+// leaplock must be held
+func audit(s int64, dir ConversionDirection, i int) {
+	window := atomic.LoadInt64(&auditWindow)
+	near := func(j int) bool {
+		if j < 0 || j >= len(leaps) {
+			return false
+		}
+		// a TAI lookup is compared with the leap on the TAI scale
+		at := leaps[j].UnixUTC
+		if dir == TAIToUTC {
+			at += leaps[j].CumulativeSkew
+		}
+		d := s - at
+		return -window <= d && d <= window
+	}
+	if !near(i) && !near(i+1) {
+		return
+	}
+	rec := AuditRecord{Direction: dir, Lookup: s, Index: i}
+	if i >= 0 {
+		rec.Entry = LeapSecond(leaps[i])
+	}
+	auditMu.Lock()
+	defer auditMu.Unlock()
+	if len(auditRing) == 0 {
+		return
+	}
+	auditRing[auditNext] = rec
+	auditNext++
+	if auditNext == len(auditRing) {
+		auditNext = 0
+		auditFull = true
+	}
+}
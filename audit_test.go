@@ -0,0 +1,71 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestAuditRecordsNearLeap(t *testing.T) {
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	tai.EnableAudit(60, 3)
+	defer tai.DisableAudit()
+	tai.Unix(leap-1, 0)
+	tai.Unix(leap+1e6, 0) // far from any leap, not recorded
+	tai.Unix(leap+1, 0).AsTime()
+	recs := tai.AuditRecords()
+	if len(recs) != 3 {
+		t.Fatalf("expected 3 records, got %d: %+v", len(recs), recs)
+	}
+	if recs[0].Direction != tai.UTCToTAI || recs[0].Index != len(table)-2 {
+		t.Errorf("expected a UTC->TAI lookup applying the prior entry, got %+v", recs[0])
+	}
+	if recs[1].Entry != table[len(table)-1] {
+		t.Errorf("expected a lookup applying the last entry, got %+v", recs[1])
+	}
+	if recs[2].Direction != tai.TAIToUTC {
+		t.Errorf("expected a TAI->UTC lookup, got %+v", recs[2])
+	}
+}
+
+func TestAuditRingOverwritesOldest(t *testing.T) {
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	tai.EnableAudit(10, 2)
+	defer tai.DisableAudit()
+	for s := leap - 5; s < leap; s++ {
+		tai.Unix(s, 0)
+	}
+	recs := tai.AuditRecords()
+	if len(recs) != 2 || recs[0].Lookup != leap-2 || recs[1].Lookup != leap-1 {
+		t.Fatalf("expected the two most recent lookups, got %+v", recs)
+	}
+	tai.DisableAudit()
+	tai.Unix(leap, 0)
+	if len(tai.AuditRecords()) != 2 {
+		t.Fatal("lookup recorded while the audit trail was disabled")
+	}
+}
+
+func TestAuditTAIToUTCNearLeap(t *testing.T) {
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	tai.EnableAudit(5, 4)
+	defer tai.DisableAudit()
+	// 40 s before the leap is within 5 s of it only if the scales are confused
+	tai.Unix(leap-40, 0).AsTime()
+	tai.Unix(leap+3, 0).AsTime()
+	var recs []tai.AuditRecord
+	for _, r := range tai.AuditRecords() {
+		if r.Direction == tai.TAIToUTC {
+			recs = append(recs, r)
+		}
+	}
+	if len(recs) != 1 {
+		t.Fatalf("expected one TAI->UTC lookup near the leap, got %+v", recs)
+	}
+	if exp := leap + 3 + table[len(table)-1].CumulativeSkew; recs[0].Lookup != exp {
+		t.Fatalf("expected the lookup of %d, got %+v", exp, recs[0])
+	}
+}
@@ -0,0 +1,108 @@
+package tai
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Backoff computes exponentially increasing retry deadlines.
+//
+// the delay before retry n (counting from zero) is Initial * Multiplier^n,
+// limited to Max.  If Jitter is nonzero, each delay is reduced by a uniformly
+// random fraction of up to Jitter, so that with Jitter = 0.5 the delay is
+// drawn from [delay/2, delay].  Jitter never increases a delay beyond Max.
+//
+// The zero value of the optional fields is usable: a zero Multiplier is
+// treated as 2, a zero Max imposes no limit, a nil Clock uses DefaultClock, and
+// a nil Rand uses the math/rand package's global source.
+//
+// Backoff is not safe for concurrent use.
+type Backoff struct {
+	// Initial is the delay before the first retry
+	Initial Duration
+	// Multiplier is the growth factor between successive delays
+	Multiplier float64
+	// Jitter is the fraction in [0, 1] by which a delay may be randomly reduced
+	Jitter float64
+	// Max is the longest permissible delay
+	Max Duration
+	// Clock is the source of the current time for Next
+	Clock Clock
+	// Rand is the source of randomness for Jitter
+	Rand *rand.Rand
+
+	attempt int
+}
+
+// Attempt returns the number of deadlines computed since the last Reset
+func (b *Backoff) Attempt() int {
+	return b.attempt
+}
+
+// Reset returns b to its initial state, such that the next delay is Initial
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
+
+// Delay returns the delay before retry n without jitter
+func (b *Backoff) Delay(n int) Duration {
+	if n <= 0 {
+		return b.capped(b.Initial)
+	}
+	mult := b.Multiplier
+	if mult == 0 {
+		mult = 2
+	}
+	s := b.Initial.Seconds() * math.Pow(mult, float64(n))
+	if b.Max != (Duration{}) && s >= b.Max.Seconds() {
+		return b.Max
+	}
+	return b.capped(durationFromSeconds(s))
+}
+
+// Next returns the deadline for the next retry as measured from b's clock,
+// and advances the attempt count
+func (b *Backoff) Next() TAI {
+	clock := b.Clock
+	if clock == nil {
+		clock = DefaultClock
+	}
+	return b.NextAfter(clock.Now())
+}
+
+// NextAfter returns the deadline for the next retry as measured from now,
+// and advances the attempt count
+func (b *Backoff) NextAfter(now TAI) TAI {
+	d := b.Delay(b.attempt)
+	b.attempt++
+	if b.Jitter > 0 {
+		var f float64
+		if b.Rand != nil {
+			f = b.Rand.Float64()
+		} else {
+			f = rand.Float64()
+		}
+		d = d.Sub(durationFromSeconds(d.Seconds() * b.Jitter * f))
+	}
+	return now.AddDuration(d)
+}
+
+func (b *Backoff) capped(d Duration) Duration {
+	if b.Max != (Duration{}) && b.Max.Less(d) {
+		return b.Max
+	}
+	return d
+}
+
+// durationFromSeconds converts a floating point number of seconds to a
+// Duration; values beyond the range of Duration saturate
+func durationFromSeconds(s float64) Duration {
+	if s >= math.MaxInt64 {
+		return Duration{sec: math.MaxInt64, asec: 1e18 - 1}
+	}
+	if s < math.MinInt64 {
+		return Duration{sec: math.MinInt64}
+	}
+	sec := math.Floor(s)
+	return Dur(int64(sec), int64((s-sec)*1e18))
+}
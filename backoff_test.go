@@ -0,0 +1,59 @@
+package tai_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestBackoffDelays(t *testing.T) {
+	b := tai.Backoff{Initial: tai.Dur(0, 100*tai.Millisecond), Max: tai.Dur(1, 0)}
+	exp := []tai.Duration{
+		tai.Dur(0, 100*tai.Millisecond),
+		tai.Dur(0, 200*tai.Millisecond),
+		tai.Dur(0, 400*tai.Millisecond),
+		tai.Dur(0, 800*tai.Millisecond),
+		tai.Dur(1, 0),
+		tai.Dur(1, 0),
+	}
+	for n, e := range exp {
+		got := b.Delay(n)
+		diff := got.Sub(e)
+		if diff.IsNegative() {
+			diff = diff.Neg()
+		}
+		if tai.Dur(0, tai.Nanosecond).Less(diff) {
+			t.Errorf("delay %d: expected %+v, got %+v", n, e, got)
+		}
+	}
+}
+
+func TestBackoffNextUsesClock(t *testing.T) {
+	clock := &fakeClock{now: tai.Date(2024, 7, 1)}
+	b := tai.Backoff{Initial: tai.Dur(1, 0), Multiplier: 3, Clock: clock}
+	if got := b.Next(); !got.Eq(clock.now.AddHMS(0, 0, 1)) {
+		t.Fatalf("first deadline %+v is not 1 s after now", got.AsGregorian())
+	}
+	if got := b.Next(); !got.Eq(clock.now.AddHMS(0, 0, 3)) {
+		t.Fatalf("second deadline %+v is not 3 s after now", got.AsGregorian())
+	}
+	if b.Attempt() != 2 {
+		t.Fatalf("expected 2 attempts, got %d", b.Attempt())
+	}
+	b.Reset()
+	if got := b.Next(); !got.Eq(clock.now.AddHMS(0, 0, 1)) {
+		t.Fatalf("deadline after reset %+v is not 1 s after now", got.AsGregorian())
+	}
+}
+
+func TestBackoffJitterBounds(t *testing.T) {
+	now := tai.Date(2024, 7, 1)
+	b := tai.Backoff{Initial: tai.Dur(10, 0), Multiplier: 1, Jitter: 0.5, Rand: rand.New(rand.NewSource(1))}
+	for i := 0; i < 1000; i++ {
+		got := b.NextAfter(now)
+		if got.Before(now.AddHMS(0, 0, 5)) || got.After(now.AddHMS(0, 0, 10)) {
+			t.Fatalf("jittered deadline %+v outside of [5, 10] s", got.AsGregorian())
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Package bench measures the performance of pkg tai against package time on
+// the same operations, so that regressions in either can be found by users on
+// their own hardware.
+//
+// Each Case is a pair of benchmarks, one of each package.  Run measures them
+// with testing.Benchmark, and WriteJSON records the results in a form that can
+// be kept and compared between releases.  They are also run by
+//
+//	go test -bench . github.com/brandondube/tai/bench
+//
+// whose output is read by benchstat, and by the bench command of cmd/tai.
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+// Case is an operation benchmarked with pkg tai and with package time
+type Case struct {
+	// Name identifies the operation, e.g. Format
+	Name string
+	// TAI and Time perform the operation b.N times
+	TAI, Time func(b *testing.B)
+}
+
+// the operands of the cases, and sinks for their results so that the
+// operations are not optimized away
+var (
+	stdTime  = time.Date(2024, 7, 1, 12, 34, 56, 789012345, time.UTC)
+	taiTime  = tai.FromTime(stdTime)
+	text     = "2024-07-01T12:34:56.789012345Z"
+	stdDelta = 36*time.Hour + 1234*time.Nanosecond
+	taiDelta = tai.Dur(36*tai.Hour, 1234*tai.Nanosecond)
+	stdEpoch = time.Unix(0, 0)
+	taiEpoch = tai.Unix(0, 0)
+
+	sinkTAI  tai.TAI
+	sinkTime time.Time
+	sinkStr  string
+	sinkInt  int64
+)
+
+// Cases returns the benchmarks of the operations common to pkg tai and package
+// time: reading the clock, formatting and parsing RFC 3339, converting to and
+// from UNIX time and the civil calendar, and arithmetic.
+func Cases() []Case {
+	return []Case{
+		{"Now", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTAI = tai.Now()
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTime = time.Now()
+			}
+		}},
+		{"Format", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkStr = taiTime.Format(tai.RFC3339Nano)
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkStr = stdTime.Format(time.RFC3339Nano)
+			}
+		}},
+		{"Parse", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTAI, _ = tai.Parse(tai.RFC3339Nano, text)
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTime, _ = time.Parse(time.RFC3339Nano, text)
+			}
+		}},
+		{"FromUnix", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTAI = tai.Unix(1719837296, 789012345)
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTime = time.Unix(1719837296, 789012345)
+			}
+		}},
+		{"ToUnix", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkInt, _ = taiTime.Unix()
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkInt = stdTime.Unix()
+			}
+		}},
+		{"Civil", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkInt = int64(taiTime.AsGregorian().Sec)
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				stdTime.Date()
+				_, _, s := stdTime.Clock()
+				sinkInt = int64(s)
+			}
+		}},
+		{"Add", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTAI = taiTime.AddDuration(taiDelta)
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkTime = stdTime.Add(stdDelta)
+			}
+		}},
+		{"Sub", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkInt, _ = taiTime.Sub(taiEpoch).Parts()
+			}
+		}, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkInt = int64(stdTime.Sub(stdEpoch))
+			}
+		}},
+	}
+}
+
+// Measurement is the outcome of one benchmark
+type Measurement struct {
+	// N is the number of iterations
+	N int `json:"n"`
+	// NsPerOp is the time of one operation in nanoseconds
+	NsPerOp float64 `json:"ns_per_op"`
+	// AllocsPerOp and BytesPerOp are the heap allocations of one operation
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+func measure(f func(b *testing.B)) Measurement {
+	r := testing.Benchmark(func(b *testing.B) {
+		b.ReportAllocs()
+		f(b)
+	})
+	m := Measurement{N: r.N, AllocsPerOp: r.AllocsPerOp(), BytesPerOp: r.AllocedBytesPerOp()}
+	if r.N > 0 {
+		m.NsPerOp = float64(r.T.Nanoseconds()) / float64(r.N)
+	}
+	return m
+}
+
+// Result is the outcome of a Case
+type Result struct {
+	Name string      `json:"name"`
+	TAI  Measurement `json:"tai"`
+	Time Measurement `json:"time"`
+}
+
+// Ratio returns the time of the operation with pkg tai relative to package
+// time; less than one is faster
+func (r Result) Ratio() float64 {
+	return r.TAI.NsPerOp / r.Time.NsPerOp
+}
+
+// Run measures each of cases in turn.  Each benchmark runs for about a second.
+func Run(cases []Case) []Result {
+	out := make([]Result, 0, len(cases))
+	for _, c := range cases {
+		out = append(out, Result{Name: c.Name, TAI: measure(c.TAI), Time: measure(c.Time)})
+	}
+	return out
+}
+
+// Report is the machine-readable form of a set of Results, with the
+// environment they were measured in
+type Report struct {
+	GoVersion string   `json:"go_version"`
+	GOOS      string   `json:"goos"`
+	GOARCH    string   `json:"goarch"`
+	NumCPU    int      `json:"num_cpu"`
+	Results   []Result `json:"results"`
+}
+
+// NewReport returns a Report of results in the current environment
+func NewReport(results []Result) Report {
+	return Report{
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		NumCPU:    runtime.NumCPU(),
+		Results:   results,
+	}
+}
+
+// WriteJSON writes the Report of results to w as indented JSON
+func WriteJSON(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(NewReport(results))
+}
+
+// WriteText writes results to w as a table for people to read
+func WriteText(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "name\ttai ns/op\ttime ns/op\tratio\ttai allocs/op\ttime allocs/op\t")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%.1f\t%.1f\t%.2f\t%d\t%d\t\n",
+			r.Name, r.TAI.NsPerOp, r.Time.NsPerOp, r.Ratio(), r.TAI.AllocsPerOp, r.Time.AllocsPerOp)
+	}
+	return tw.Flush()
+}
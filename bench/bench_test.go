@@ -0,0 +1,77 @@
+package bench_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/brandondube/tai/bench"
+)
+
+func BenchmarkCases(b *testing.B) {
+	for _, c := range bench.Cases() {
+		b.Run(c.Name+"/tai", c.TAI)
+		b.Run(c.Name+"/time", c.Time)
+	}
+}
+
+func TestCases(t *testing.T) {
+	seen := map[string]bool{}
+	for _, c := range bench.Cases() {
+		if c.Name == "" || c.TAI == nil || c.Time == nil {
+			t.Fatalf("incomplete case %+v", c)
+		}
+		if seen[c.Name] {
+			t.Fatalf("duplicate case %s", c.Name)
+		}
+		seen[c.Name] = true
+	}
+}
+
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("benchmarks run for about a second each")
+	}
+	res := bench.Run(bench.Cases()[:1])
+	if len(res) != 1 || res[0].Name != "Now" {
+		t.Fatalf("expected one result for Now, got %+v", res)
+	}
+	if r := res[0]; r.TAI.N == 0 || r.Time.N == 0 || r.TAI.NsPerOp <= 0 || r.Ratio() <= 0 {
+		t.Fatalf("expected nonzero measurements, got %+v", r)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	res := []bench.Result{{
+		Name: "Format",
+		TAI:  bench.Measurement{N: 1000, NsPerOp: 150, AllocsPerOp: 1, BytesPerOp: 32},
+		Time: bench.Measurement{N: 1000, NsPerOp: 100, AllocsPerOp: 1, BytesPerOp: 32},
+	}}
+	var buf bytes.Buffer
+	if err := bench.WriteJSON(&buf, res); err != nil {
+		t.Fatal(err)
+	}
+	var rep bench.Report
+	if err := json.Unmarshal(buf.Bytes(), &rep); err != nil {
+		t.Fatal(err)
+	}
+	if rep.GoVersion == "" || len(rep.Results) != 1 || rep.Results[0] != res[0] {
+		t.Fatalf("expected the results to round trip, got %+v", rep)
+	}
+	if r := rep.Results[0].Ratio(); r != 1.5 {
+		t.Fatalf("expected ratio 1.5, got %v", r)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	res := []bench.Result{{Name: "Parse", TAI: bench.Measurement{NsPerOp: 50}, Time: bench.Measurement{NsPerOp: 100}}}
+	var buf bytes.Buffer
+	if err := bench.WriteText(&buf, res); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[1], "Parse") || !strings.Contains(lines[1], "0.50") {
+		t.Fatalf("unexpected table:\n%s", buf.String())
+	}
+}
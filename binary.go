@@ -0,0 +1,51 @@
+package tai
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// BinaryLen is the length of the binary encoding of a TAI; see func
+// MarshalBinary
+const BinaryLen = 16
+
+// AppendBinary appends the binary encoding of t to b and returns the extended
+// slice; see func MarshalBinary
+func (t TAI) AppendBinary(b []byte) []byte {
+	var buf [BinaryLen]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(t.sec))
+	binary.BigEndian.PutUint64(buf[8:], uint64(t.asec))
+	return append(b, buf[:]...)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.  The encoding is always
+// BinaryLen bytes: the seconds and then the attoseconds of t, each as a
+// big-endian two's complement int64.  It is lossless and stable, and among
+// times after the TAI epoch it sorts bytewise in time order.
+func (t TAI) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(make([]byte, 0, BinaryLen)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (t *TAI) UnmarshalBinary(b []byte) error {
+	v, err := decodeBinary(b)
+	if err != nil {
+		return fmt.Errorf("TAI.UnmarshalBinary: %w", err)
+	}
+	*t = v
+	return nil
+}
+
+// decodeBinary decodes the binary encoding of a TAI
+func decodeBinary(b []byte) (TAI, error) {
+	if len(b) != BinaryLen {
+		return TAI{}, fmt.Errorf("expected %d bytes, got %d", BinaryLen, len(b))
+	}
+	sec := int64(binary.BigEndian.Uint64(b))
+	asec := int64(binary.BigEndian.Uint64(b[8:]))
+	if asec < 0 || asec >= 1e18 {
+		return TAI{}, errors.New("attoseconds out of range")
+	}
+	return TAI{sec: sec, asec: asec}, nil
+}
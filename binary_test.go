@@ -0,0 +1,55 @@
+package tai_test
+
+import (
+	"bytes"
+	"encoding"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = tai.TAI{}
+	_ encoding.BinaryUnmarshaler = (*tai.TAI)(nil)
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, ta := range []tai.TAI{tai.Tai(0, 0), tai.Date(2024, 7, 1).Add(0, 1), tai.Tai(-1, 25e16), tai.Tai(1<<62, 1e18-1)} {
+		b, err := ta.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != tai.BinaryLen {
+			t.Fatalf("expected %d bytes, got %d", tai.BinaryLen, len(b))
+		}
+		var got tai.TAI
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Eq(ta) {
+			t.Errorf("expected %+v, got %+v", ta, got)
+		}
+	}
+}
+
+func TestBinaryLayout(t *testing.T) {
+	b, _ := tai.Tai(1, 2).MarshalBinary()
+	exp := []byte{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2}
+	if !bytes.Equal(b, exp) {
+		t.Fatalf("expected %v, got %v", exp, b)
+	}
+	if got := tai.Tai(1, 2).AppendBinary([]byte{9}); !bytes.Equal(got, append([]byte{9}, exp...)) {
+		t.Errorf("expected the encoding appended, got %v", got)
+	}
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	var ta tai.TAI
+	if err := ta.UnmarshalBinary(make([]byte, 15)); err == nil {
+		t.Error("expected an error for a short encoding")
+	}
+	bad := bytes.Repeat([]byte{0xff}, tai.BinaryLen)
+	if err := ta.UnmarshalBinary(bad); err == nil {
+		t.Error("expected an error for negative attoseconds")
+	}
+}
@@ -0,0 +1,90 @@
+package tai
+
+// unknownClockError is the radius of uncertainty assumed of a host clock that
+// reports no error bound of its own
+const unknownClockError = 1 * Second
+
+// halfYear is the spacing of the dates on which a leap second may occur: the
+// IERS schedules them at the end of June or December
+const halfYear = 366 * Day / 2
+
+// BoundedTAI is a TAI moment known only to lie within the closed interval
+// [Earliest, Latest], such as the reading of an imperfect clock
+type BoundedTAI struct {
+	Earliest, Latest TAI
+}
+
+// Bound returns the BoundedTAI of t with the given radius of uncertainty
+func Bound(t TAI, radius Duration) BoundedTAI {
+	if radius.IsNegative() {
+		radius = radius.Neg()
+	}
+	return BoundedTAI{Earliest: t.AddDuration(radius.Neg()), Latest: t.AddDuration(radius)}
+}
+
+// Before returns true if b is certainly before o, that is, their intervals
+// do not overlap and b's is first
+func (b BoundedTAI) Before(o BoundedTAI) bool {
+	return b.Latest.Before(o.Earliest)
+}
+
+// After returns true if b is certainly after o
+func (b BoundedTAI) After(o BoundedTAI) bool {
+	return o.Before(b)
+}
+
+// Overlaps returns true if b and o could be the same moment, so that neither
+// is certainly before the other
+func (b BoundedTAI) Overlaps(o BoundedTAI) bool {
+	return !b.Before(o) && !o.Before(b)
+}
+
+// Contains returns true if t is within b
+func (b BoundedTAI) Contains(t TAI) bool {
+	return !t.Before(b.Earliest) && !t.After(b.Latest)
+}
+
+// Radius returns half of the width of b
+func (b BoundedTAI) Radius() Duration {
+	return sub(b.Latest, b.Earliest).div(2)
+}
+
+// NowBounded returns the current moment with the uncertainty of the host
+// clock and of the leap second table.
+//
+// the clock contributes its resolution and its ErrorBound: if synchronized,
+// the error bound reported by the host (see func HostClockQuality); an
+// unsynchronized clock, or one that reports no bound, contributes its reported
+// maximum error or one second, whichever is greater.  For each date on which a leap second may
+// have been scheduled since the leap second table expired, one second is
+// added, as the table may lack it.
+func NowBounded() BoundedTAI {
+	now := Now()
+	q, _ := HostClockQuality()
+	radius := q.Resolution.Add(q.ErrorBound())
+	secs, _ := now.unix()
+	radius = radius.Add(Dur(possibleLeaps(tableExpiry(), secs), 0))
+	return Bound(now, radius)
+}
+
+// ErrorBound returns the bound on the error of the clock assumed by
+// NowBounded: MaxError if the clock is Synchronized and reports one, and
+// otherwise MaxError or one second, whichever is greater
+func (q ClockQuality) ErrorBound() Duration {
+	if q.Status == Synchronized && q.MaxError != (Duration{}) {
+		return q.MaxError
+	}
+	if q.MaxError.Less(Dur(unknownClockError, 0)) {
+		return Dur(unknownClockError, 0)
+	}
+	return q.MaxError
+}
+
+// possibleLeaps returns the number of dates on which a leap second may have
+// occurred from the UNIX time expires up to now
+func possibleLeaps(expires, now int64) int64 {
+	if now <= expires {
+		return 0
+	}
+	return (now-expires)/halfYear + 1
+}
@@ -0,0 +1,82 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestBoundedTAIOrdering(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	a := tai.Bound(base, tai.Dur(1, 0))
+	cases := []struct {
+		descr         string
+		b             tai.BoundedTAI
+		before, after bool
+	}{
+		{"Disjoint", tai.Bound(base.Add(3, 0), tai.Dur(1, 0)), true, false},
+		{"Touching", tai.Bound(base.Add(2, 0), tai.Dur(1, 0)), false, false},
+		{"Overlapping", tai.Bound(base.Add(1, 0), tai.Dur(1, 0)), false, false},
+		{"Earlier", tai.Bound(base.Add(-5, 0), tai.Dur(1, 0)), false, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := a.Before(tc.b); got != tc.before {
+				t.Fatalf("expected Before=%v, got %v", tc.before, got)
+			}
+			if got := a.After(tc.b); got != tc.after {
+				t.Fatalf("expected After=%v, got %v", tc.after, got)
+			}
+			if got := a.Overlaps(tc.b); got != (!tc.before && !tc.after) {
+				t.Fatalf("expected Overlaps=%v, got %v", !tc.before && !tc.after, got)
+			}
+		})
+	}
+}
+
+func TestBound(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	b := tai.Bound(base, tai.Dur(0, -5e17))
+	if !b.Earliest.Eq(base.Add(0, -5e17)) || !b.Latest.Eq(base.Add(0, 5e17)) {
+		t.Fatalf("expected a negative radius to be treated as positive, got %+v", b)
+	}
+	if r := b.Radius(); !r.Eq(tai.Dur(0, 5e17)) {
+		t.Fatalf("expected a radius of 0.5 s, got %v", r)
+	}
+	if !b.Contains(base) || !b.Contains(b.Latest) || b.Contains(base.Add(1, 0)) {
+		t.Fatal("unexpected result of Contains")
+	}
+}
+
+func TestNowBounded(t *testing.T) {
+	b := tai.NowBounded()
+	now := tai.Now()
+	if !b.Contains(now) {
+		t.Fatalf("expected %+v to contain Now, %+v", b, now)
+	}
+	if b.Radius().Less(tai.NowPrecision()) {
+		t.Fatalf("expected a radius of at least the clock's resolution, got %v", b.Radius())
+	}
+}
+
+func TestClockQualityErrorBound(t *testing.T) {
+	second := tai.Dur(1, 0)
+	cases := []struct {
+		descr string
+		q     tai.ClockQuality
+		exp   tai.Duration
+	}{
+		{"Default", tai.ClockQuality{}, second},
+		{"SynchronizedWithoutBound", tai.ClockQuality{Status: tai.Synchronized}, second},
+		{"Synchronized", tai.ClockQuality{Status: tai.Synchronized, MaxError: tai.Dur(0, 5*tai.Millisecond)}, tai.Dur(0, 5*tai.Millisecond)},
+		{"UnsynchronizedSmall", tai.ClockQuality{Status: tai.Unsynchronized, MaxError: tai.Dur(0, 5*tai.Millisecond)}, second},
+		{"UnsynchronizedLarge", tai.ClockQuality{Status: tai.Unsynchronized, MaxError: tai.Dur(3, 0)}, tai.Dur(3, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.q.ErrorBound(); got != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+		})
+	}
+}
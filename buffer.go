@@ -0,0 +1,62 @@
+package tai
+
+import (
+	"io"
+	"sync"
+)
+
+// Buffer is a reusable scratch space for formatting TAI values without
+// allocating.  After the first few calls have grown it to size, formatting
+// into a Buffer performs no allocations.
+//
+// The zero value of Buffer is ready to use.  A Buffer must not be used
+// concurrently; use one per goroutine, or a sync.Pool.
+type Buffer struct {
+	b []byte
+}
+
+// Format formats t according to fmtspec (see func Format) into the buffer,
+// replacing its contents.  The returned slice aliases the buffer, and is only
+// valid until the next call that modifies it.
+func (b *Buffer) Format(t TAI, fmtspec string) []byte {
+	b.b = appendFormat(b.b[:0], t.AsGregorian(), fmtspec)
+	return b.b
+}
+
+// FormatGregorian is equivalent to Format, for an already converted g
+func (b *Buffer) FormatGregorian(g Gregorian, fmtspec string) []byte {
+	b.b = appendFormat(b.b[:0], g, fmtspec)
+	return b.b
+}
+
+// Bytes returns the contents of the buffer, aliasing it
+func (b *Buffer) Bytes() []byte {
+	return b.b
+}
+
+// String returns a copy of the contents of the buffer as a string
+func (b *Buffer) String() string {
+	return string(b.b)
+}
+
+// Reset empties the buffer, retaining its storage
+func (b *Buffer) Reset() {
+	b.b = b.b[:0]
+}
+
+// AppendFormat is like Format, but appends the textual representation of t to
+// b and returns the extended slice.
+func (t TAI) AppendFormat(b []byte, fmtspec string) []byte {
+	return appendFormat(b, t.AsGregorian(), fmtspec)
+}
+
+var bufferPool = sync.Pool{New: func() interface{} { return new(Buffer) }}
+
+// FormatTo writes t formatted according to fmtspec (see func Format) to w.  It
+// does not allocate, except as w itself does.
+func (t TAI) FormatTo(w io.Writer, fmtspec string) (int, error) {
+	buf := bufferPool.Get().(*Buffer)
+	n, err := w.Write(buf.Format(t, fmtspec))
+	bufferPool.Put(buf)
+	return n, err
+}
@@ -0,0 +1,74 @@
+package tai_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestBufferFormat(t *testing.T) {
+	ta := tai.Date(2024, 7, 1).AddHMS(12, 34, 56).Add(0, 789*tai.Millisecond)
+	var buf tai.Buffer
+	for _, spec := range []string{tai.RFC3339, "%Y%m%d", "%A %B %d"} {
+		if got, exp := string(buf.Format(ta, spec)), ta.Format(spec); got != exp {
+			t.Errorf("%s: expected %q, got %q", spec, exp, got)
+		}
+		if buf.String() != ta.Format(spec) {
+			t.Errorf("%s: String disagrees with Format", spec)
+		}
+	}
+	buf.Reset()
+	if len(buf.Bytes()) != 0 {
+		t.Fatal("expected an empty buffer after Reset")
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	ta := tai.Date(2000, 1, 1)
+	got := string(ta.AppendFormat([]byte("t="), "%Y-%m-%d"))
+	if got != "t=2000-01-01" {
+		t.Fatalf("expected t=2000-01-01, got %q", got)
+	}
+}
+
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, errors.New("boom") }
+
+func TestFormatTo(t *testing.T) {
+	ta := tai.Date(2024, 7, 1).AddHMS(1, 2, 3)
+	var w bytes.Buffer
+	n, err := ta.FormatTo(&w, tai.RFC3339)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := ta.Format(tai.RFC3339); w.String() != exp || n != len(exp) {
+		t.Fatalf("expected %q, got %q (n=%d)", exp, w.String(), n)
+	}
+	if _, err := ta.FormatTo(failWriter{}, tai.RFC3339); err == nil {
+		t.Fatal("expected the writer's error to be returned")
+	}
+}
+
+func TestBufferDoesNotAllocate(t *testing.T) {
+	ta := tai.Date(2024, 7, 1).AddHMS(1, 2, 3)
+	var buf tai.Buffer
+	buf.Format(ta, tai.RFC3339Nano)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf.Format(ta, tai.RFC3339Nano)
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkFormatTo(b *testing.B) {
+	ta := tai.Now()
+	var w bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		w.Reset()
+		ta.FormatTo(&w, tai.RFC3339Nano)
+	}
+}
@@ -0,0 +1,70 @@
+package tai
+
+import "sync/atomic"
+
+// CachedDay caches the calendar date of the most recently seen TAI day, so
+// that breaking down many instants on the same day, as a logger does, costs
+// only the time of day arithmetic.  The cache is refreshed when an instant on
+// another day is seen.
+//
+// The zero value of CachedDay is ready to use.  CachedDay is safe for
+// concurrent use; readers never block.
+type CachedDay struct {
+	v atomic.Value // *cachedDay
+}
+
+type cachedDay struct {
+	start, end TAI
+	// date is the Gregorian breakdown of start
+	date Gregorian
+}
+
+// Day returns the start and end of the TAI day containing t and its date, at
+// midnight.  The day is the half-open interval [start, end).
+func (c *CachedDay) Day(t TAI) (start, end TAI, date Gregorian) {
+	cd := c.load(t)
+	return cd.start, cd.end, cd.date
+}
+
+// AsGregorian is equivalent to t.AsGregorian()
+func (c *CachedDay) AsGregorian(t TAI) Gregorian {
+	return c.load(t).gregorian(t)
+}
+
+// gregorian returns the breakdown of t, which must be within the day
+func (cd *cachedDay) gregorian(t TAI) Gregorian {
+	g := cd.date
+	rem := t.sec - cd.start.sec
+	g.Hour = int(rem / Hour)
+	rem %= Hour
+	g.Min = int(rem / Minute)
+	g.Sec = int(rem % Minute)
+	g.Asec = t.asec
+	return g
+}
+
+// Format is equivalent to t.Format(fmtspec)
+func (c *CachedDay) Format(t TAI, fmtspec string) string {
+	return FormatGregorian(c.AsGregorian(t), fmtspec)
+}
+
+func (c *CachedDay) load(t TAI) *cachedDay {
+	if cd, ok := c.v.Load().(*cachedDay); ok && cd.contains(t) {
+		return cd
+	}
+	cd := newCachedDay(t)
+	c.v.Store(cd)
+	return cd
+}
+
+// newCachedDay returns the day containing t
+func newCachedDay(t TAI) *cachedDay {
+	days, _ := floorDiv(t.sec, Day)
+	start := TAI{sec: days * Day}
+	return &cachedDay{start: start, end: TAI{sec: start.sec + Day}, date: start.AsGregorian()}
+}
+
+// contains returns true if t is within the day
+func (cd *cachedDay) contains(t TAI) bool {
+	return !t.Before(cd.start) && t.Before(cd.end)
+}
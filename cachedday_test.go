@@ -0,0 +1,66 @@
+package tai_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCachedDayMatchesAsGregorian(t *testing.T) {
+	var c tai.CachedDay
+	base := tai.Date(2024, 2, 28).AddHMS(23, 59, 58)
+	for i := 0; i < 5; i++ {
+		ta := base.Add(int64(i), 123)
+		exp := ta.AsGregorian()
+		got := c.AsGregorian(ta)
+		if got != exp {
+			t.Fatalf("expected %+v, got %+v", exp, got)
+		}
+	}
+	// revisiting an earlier day refreshes the cache
+	if got, exp := c.AsGregorian(tai.Tai(-1, 0)), tai.Tai(-1, 0).AsGregorian(); got != exp {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+}
+
+func TestCachedDayBounds(t *testing.T) {
+	var c tai.CachedDay
+	start, end, date := c.Day(tai.Date(2024, 7, 1).AddHMS(13, 0, 0))
+	if !start.Eq(tai.Date(2024, 7, 1)) || !end.Eq(tai.Date(2024, 7, 2)) {
+		t.Fatalf("unexpected day bounds [%+v, %+v)", start.AsGregorian(), end.AsGregorian())
+	}
+	if date.Day != 1 || date.Month != tai.July || date.Hour != 0 {
+		t.Fatalf("unexpected date %+v", date)
+	}
+}
+
+func TestCachedDayConcurrent(t *testing.T) {
+	var (
+		c  tai.CachedDay
+		wg sync.WaitGroup
+	)
+	base := tai.Date(2024, 7, 1)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				ta := base.AddHMS(0, 0, (g*1000+i)*97)
+				if c.Format(ta, tai.RFC3339) != ta.Format(tai.RFC3339) {
+					t.Error("cached format disagrees with Format")
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func BenchmarkCachedDayFormat(b *testing.B) {
+	var c tai.CachedDay
+	now := tai.Now()
+	for i := 0; i < b.N; i++ {
+		c.Format(now, tai.RFC3339Micro)
+	}
+}
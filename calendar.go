@@ -0,0 +1,99 @@
+package tai
+
+// Calendar is a business or operations calendar of working days, for
+// scheduling with func (TAI) AddWorkingDays.  Days are those of the TAI
+// calendar (see func AsGregorian).
+type Calendar interface {
+	// IsWorkingDay returns true if the day containing t is a working day
+	IsWorkingDay(t TAI) bool
+	// NextWorkingDay returns the instant at the same time of day as t on the
+	// first working day after the day containing t
+	NextWorkingDay(t TAI) TAI
+}
+
+// WorkWeek is a Calendar of the days of the week, less holidays.  The zero
+// value works Monday to Friday without holidays.
+type WorkWeek struct {
+	// Weekend are the days of the week that are not working days; nil is
+	// Saturday and Sunday
+	Weekend []Weekday
+	// Holiday returns true if the day containing t is a holiday; nil is no
+	// holidays.  See func Holidays.
+	Holiday func(t TAI) bool
+}
+
+// MondayToFriday is the Calendar of the zero WorkWeek
+var MondayToFriday Calendar = WorkWeek{}
+
+// IsWorkingDay implements Calendar
+func (w WorkWeek) IsWorkingDay(t TAI) bool {
+	weekend := w.Weekend
+	if weekend == nil {
+		weekend = []Weekday{Saturday, Sunday}
+	}
+	wd := t.Weekday()
+	for _, d := range weekend {
+		if d == wd {
+			return false
+		}
+	}
+	return w.Holiday == nil || !w.Holiday(t)
+}
+
+// NextWorkingDay implements Calendar.  It panics if there is no working day
+// within a 400 year cycle of the calendar.
+func (w WorkWeek) NextWorkingDay(t TAI) TAI {
+	for i := 0; i < eraDays; i++ {
+		t.sec += Day
+		if w.IsWorkingDay(t) {
+			return t
+		}
+	}
+	panic("tai.WorkWeek.NextWorkingDay: calendar has no working days")
+}
+
+// Holidays returns a WorkWeek.Holiday of the days containing each of dates
+func Holidays(dates ...TAI) func(TAI) bool {
+	days := make(map[int64]bool, len(dates))
+	for _, d := range dates {
+		days[d.day()] = true
+	}
+	return func(t TAI) bool {
+		return days[t.day()]
+	}
+}
+
+// day returns the number of the day containing t in the TAI calendar
+func (t TAI) day() int64 {
+	d, _ := floorDiv(t.sec, Day)
+	return d
+}
+
+// AddWorkingDays returns the instant at the same time of day as t, n working
+// days of cal later, or earlier if n is negative.  If n is zero, t is
+// returned even if it is not on a working day.  A nil cal is MondayToFriday.
+//
+// counting backward uses only IsWorkingDay, and panics if there is no working
+// day within a 400 year cycle of the calendar.
+func (t TAI) AddWorkingDays(n int, cal Calendar) TAI {
+	if cal == nil {
+		cal = MondayToFriday
+	}
+	for ; n > 0; n-- {
+		t = cal.NextWorkingDay(t)
+	}
+	for ; n < 0; n++ {
+		t = previousWorkingDay(t, cal)
+	}
+	return t
+}
+
+func previousWorkingDay(t TAI, cal Calendar) TAI {
+	for i := 0; i < eraDays; i++ {
+		t.sec -= Day
+		if cal.IsWorkingDay(t) {
+			return t
+		}
+	}
+	panic("tai.AddWorkingDays: calendar has no working days")
+}
@@ -0,0 +1,65 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestAddWorkingDays(t *testing.T) {
+	// Wednesday, July 3, 2024 at 09:30, before the Independence Day holiday
+	wed := tai.Date(2024, 7, 3).AddHMS(9, 30, 0)
+	us := tai.WorkWeek{Holiday: tai.Holidays(tai.Date(2024, 7, 4))}
+	cases := []struct {
+		descr string
+		n     int
+		cal   tai.Calendar
+		exp   tai.TAI
+	}{
+		{"Zero", 0, nil, wed},
+		{"One", 1, nil, tai.Date(2024, 7, 4).AddHMS(9, 30, 0)},
+		{"SkipsWeekend", 3, nil, tai.Date(2024, 7, 8).AddHMS(9, 30, 0)},
+		{"SkipsHoliday", 1, us, tai.Date(2024, 7, 5).AddHMS(9, 30, 0)},
+		{"SkipsHolidayAndWeekend", 2, us, tai.Date(2024, 7, 8).AddHMS(9, 30, 0)},
+		{"Backward", -3, nil, tai.Date(2024, 6, 28).AddHMS(9, 30, 0)},
+		{"SundayToThursdayWeek", 2, tai.WorkWeek{Weekend: []tai.Weekday{tai.Friday, tai.Saturday}}, tai.Date(2024, 7, 7).AddHMS(9, 30, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := wed.AddWorkingDays(tc.n, tc.cal); !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp.AsGregorian(), got.AsGregorian())
+			}
+		})
+	}
+}
+
+func TestWorkWeekIsWorkingDay(t *testing.T) {
+	cal := tai.WorkWeek{Holiday: tai.Holidays(tai.Date(2024, 12, 25).AddHMS(15, 0, 0))}
+	cases := []struct {
+		descr string
+		t     tai.TAI
+		exp   bool
+	}{
+		{"Weekday", tai.Date(2024, 12, 24), true},
+		{"Holiday", tai.Date(2024, 12, 25).AddHMS(23, 59, 59), false},
+		{"Saturday", tai.Date(2024, 12, 28), false},
+		{"BeforeEpoch", tai.Date(1957, 12, 31), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := cal.IsWorkingDay(tc.t); got != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestWorkWeekNoWorkingDays(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+	all := tai.WorkWeek{Weekend: []tai.Weekday{tai.Sunday, tai.Monday, tai.Tuesday, tai.Wednesday, tai.Thursday, tai.Friday, tai.Saturday}}
+	tai.Date(2024, 7, 1).AddWorkingDays(1, all)
+}
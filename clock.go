@@ -0,0 +1,17 @@
+package tai
+
+// Clock is a source of the current TAI moment.  Types that read the time
+// accept a Clock so that tests and simulations may substitute their own.
+type Clock interface {
+	Now() TAI
+}
+
+// SystemClock is the Clock backed by the host's realtime clock and the leap
+// second table; see func Now
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() TAI {
+	return Now()
+}
@@ -0,0 +1,55 @@
+package tai
+
+import "fmt"
+
+// SyncStatus is the state of the host's time synchronization
+type SyncStatus int
+
+const (
+	// SyncUnknown means the host does not report its synchronization
+	SyncUnknown SyncStatus = iota
+	// Unsynchronized means the host clock is free running, or its time daemon
+	// has lost its sources
+	Unsynchronized
+	// Synchronized means the host clock is disciplined by a time daemon
+	Synchronized
+)
+
+var syncStatusNames = [...]string{"Unknown", "Unsynchronized", "Synchronized"}
+
+// String returns the name of s, e.g. Synchronized
+func (s SyncStatus) String() string {
+	if s < SyncUnknown || s > Synchronized {
+		return fmt.Sprintf("SyncStatus(%d)", int(s))
+	}
+	return syncStatusNames[s]
+}
+
+// ClockQuality describes how well Now represents the true time, so that data
+// may be annotated with a realistic uncertainty rather than the attosecond
+// resolution of TAI.
+type ClockQuality struct {
+	// Resolution is the resolution of the host clock; see func NowPrecision
+	Resolution Duration
+	// Status is the state of the host's time synchronization
+	Status SyncStatus
+	// MaxError is the host's bound on the error of its clock, and EstError its
+	// estimate of the error.  They are zero if the host does not report them.
+	MaxError, EstError Duration
+	// KernelTAIOffset is TAI-UTC in seconds as known to the host kernel, or
+	// zero if unknown.  It can be compared with the leap second table of pkg
+	// tai to detect that one of them is stale.
+	KernelTAIOffset int
+}
+
+// HostClockQuality returns the quality of the host clock read by Now.  The
+// synchronization status and error bounds are reported by adjtimex(2) on
+// Linux; elsewhere the Status is SyncUnknown.
+func HostClockQuality() (ClockQuality, error) {
+	q, err := hostClockQuality()
+	if err != nil {
+		return ClockQuality{}, fmt.Errorf("HostClockQuality: %w", err)
+	}
+	q.Resolution = NowPrecision()
+	return q, nil
+}
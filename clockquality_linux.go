@@ -0,0 +1,34 @@
+package tai
+
+import "syscall"
+
+const (
+	// timeError is the adjtimex(2) clock state of an unsynchronized clock
+	timeError = 5
+	// staUnsync is the adjtimex(2) status flag of an unsynchronized clock
+	staUnsync = 0x40
+)
+
+func hostClockQuality() (ClockQuality, error) {
+	var tx syscall.Timex // Modes of zero only reads
+	state, err := syscall.Adjtimex(&tx)
+	if err != nil {
+		return ClockQuality{}, err
+	}
+	q := ClockQuality{
+		Status:          Synchronized,
+		MaxError:        microDuration(int64(tx.Maxerror)),
+		EstError:        microDuration(int64(tx.Esterror)),
+		KernelTAIOffset: int(tx.Tai),
+	}
+	if state == timeError || tx.Status&staUnsync != 0 {
+		q.Status = Unsynchronized
+	}
+	return q, nil
+}
+
+// microDuration returns us microseconds; the maximum error of an
+// unsynchronized clock, 16 s, overflows int64 attoseconds
+func microDuration(us int64) Duration {
+	return Dur(us/1e6, (us%1e6)*Microsecond)
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package tai
+
+func hostClockQuality() (ClockQuality, error) {
+	return ClockQuality{}, nil
+}
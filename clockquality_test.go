@@ -0,0 +1,34 @@
+package tai_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestHostClockQuality(t *testing.T) {
+	q, err := tai.HostClockQuality()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Resolution.Eq(tai.NowPrecision()) {
+		t.Fatalf("expected the resolution of NowPrecision, got %v", q.Resolution)
+	}
+	if runtime.GOOS == "linux" && q.Status == tai.SyncUnknown {
+		t.Fatal("expected adjtimex to report the synchronization status")
+	}
+	if q.MaxError.IsNegative() || q.EstError.IsNegative() {
+		t.Fatalf("expected nonnegative error bounds, got %+v", q)
+	}
+	t.Logf("%v, max error %v, kernel TAI offset %d", q.Status, q.MaxError.AsStdDuration(), q.KernelTAIOffset)
+}
+
+func TestSyncStatusString(t *testing.T) {
+	if s := tai.Synchronized.String(); s != "Synchronized" {
+		t.Fatalf("expected Synchronized, got %s", s)
+	}
+	if s := tai.SyncStatus(9).String(); s != "SyncStatus(9)" {
+		t.Fatalf("expected SyncStatus(9), got %s", s)
+	}
+}
@@ -0,0 +1,84 @@
+package tai
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// ClockSource is a host clock from which a SourceClock reads the time.  The
+// sources trade steering for stability: Realtime follows every correction of
+// the host's time synchronization, including steps, while the monotonic
+// sources only count elapsed time and so drift from UTC without ever jumping.
+type ClockSource int
+
+const (
+	// Realtime is the host's wall clock, as read by func Now
+	Realtime ClockSource = iota
+	// Monotonic is the host's monotonic clock, which is never stepped but whose
+	// rate may be slewed by time synchronization (CLOCK_MONOTONIC)
+	Monotonic
+	// MonotonicRaw is the host's monotonic clock without rate corrections, the
+	// hardware oscillator unsteered (CLOCK_MONOTONIC_RAW).  On macOS, whose
+	// monotonic clock is not steered, it is the same clock as Monotonic.
+	MonotonicRaw
+	// ClockTAI is the host kernel's TAI clock, which is Realtime plus the TAI
+	// offset maintained by the time synchronization daemon (CLOCK_TAI).  The
+	// leap second table of pkg tai is not consulted.
+	ClockTAI
+)
+
+var clockSourceNames = [...]string{"Realtime", "Monotonic", "MonotonicRaw", "ClockTAI"}
+
+// String returns the name of s, e.g. MonotonicRaw
+func (s ClockSource) String() string {
+	if s < Realtime || s > ClockTAI {
+		return fmt.Sprintf("ClockSource(%d)", int(s))
+	}
+	return clockSourceNames[s]
+}
+
+// SourceClock is a Clock that reads a particular ClockSource.
+//
+// the monotonic sources are anchored to Now when the SourceClock is created,
+// and thereafter advance by the elapsed time of the source alone.
+type SourceClock struct {
+	src ClockSource
+	// read returns the source in nanoseconds, relative to an origin that is
+	// arbitrary for the monotonic sources
+	read   func() int64
+	anchor TAI
+	base   int64
+}
+
+// NewClock returns a Clock reading src.  An error is returned if src is not
+// available on the host: every source is available on Linux, while other
+// systems provide Realtime and Monotonic, and MonotonicRaw on macOS, where it
+// reads the same clock as Monotonic.
+func NewClock(src ClockSource) (*SourceClock, error) {
+	read, err := clockSourceReader(src)
+	if err != nil {
+		return nil, fmt.Errorf("NewClock: %v is not available on %s: %w", src, runtime.GOOS, err)
+	}
+	c := &SourceClock{src: src, read: read}
+	if src == Monotonic || src == MonotonicRaw {
+		c.anchor, c.base = Now(), read()
+	}
+	return c, nil
+}
+
+// Source returns the source read by c
+func (c *SourceClock) Source() ClockSource {
+	return c.src
+}
+
+// Now returns the current TAI moment from the source of c
+func (c *SourceClock) Now() TAI {
+	switch c.src {
+	case Realtime:
+		return Now()
+	case ClockTAI:
+		s, ns := floorDiv(c.read(), 1e9)
+		return Tai(s+unixEpochSkew, ns*Nanosecond)
+	}
+	return c.anchor.AddDuration(Dur(0, (c.read()-c.base)*Nanosecond))
+}
@@ -0,0 +1,56 @@
+package tai
+
+import (
+	"errors"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// clock IDs of clock_gettime(2)
+const (
+	clockRealtime     = 0
+	clockMonotonic    = 1
+	clockMonotonicRaw = 4
+	clockTAI          = 11
+)
+
+func clockSourceReader(src ClockSource) (func() int64, error) {
+	var id uintptr
+	switch src {
+	case Realtime:
+		id = clockRealtime
+	case Monotonic:
+		id = clockMonotonic
+	case MonotonicRaw:
+		id = clockMonotonicRaw
+	case ClockTAI:
+		id = clockTAI
+	default:
+		return nil, errors.New("unknown clock source")
+	}
+	if _, err := clockGettime(id); err != nil {
+		return nil, err
+	}
+	if src == ClockTAI {
+		// the kernel's TAI offset is zero until set by the time synchronization
+		// daemon, in which case CLOCK_TAI is merely CLOCK_REALTIME
+		tai, _ := clockGettime(clockTAI)
+		if tai-time.Now().UnixNano() < int64(time.Second/2) {
+			return nil, errors.New("the kernel's TAI offset is not set")
+		}
+	}
+	return func() int64 {
+		ns, _ := clockGettime(id)
+		return ns
+	}, nil
+}
+
+// clockGettime returns the time of clock id in nanoseconds
+func clockGettime(id uintptr) (int64, error) {
+	var ts syscall.Timespec
+	if _, _, errno := syscall.Syscall(syscall.SYS_CLOCK_GETTIME, id, uintptr(unsafe.Pointer(&ts)), 0); errno != 0 {
+		return 0, errno
+	}
+	return ts.Nano(), nil
+}
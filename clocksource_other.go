@@ -0,0 +1,33 @@
+//go:build !linux
+// +build !linux
+
+package tai
+
+import (
+	"errors"
+	"runtime"
+	"time"
+)
+
+// start is the origin of the monotonic reading of the time package
+var start = time.Now()
+
+func clockSourceReader(src ClockSource) (func() int64, error) {
+	switch src {
+	case Realtime:
+		return hostNanos, nil
+	case MonotonicRaw:
+		// the runtime's monotonic clock is mach_absolute_time on macOS, which is
+		// not steered, so the two sources are the same clock there; elsewhere
+		// it may be steered
+		if runtime.GOOS != "darwin" {
+			return nil, errors.New("no unsteered clock")
+		}
+		fallthrough
+	case Monotonic:
+		return func() int64 { return int64(time.Since(start)) }, nil
+	case ClockTAI:
+		return nil, errors.New("no kernel TAI clock")
+	}
+	return nil, errors.New("unknown clock source")
+}
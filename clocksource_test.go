@@ -0,0 +1,53 @@
+package tai_test
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestNewClock(t *testing.T) {
+	cases := []struct {
+		src      tai.ClockSource
+		required bool
+	}{
+		{tai.Realtime, true},
+		{tai.Monotonic, true},
+		{tai.MonotonicRaw, runtime.GOOS == "linux" || runtime.GOOS == "darwin"},
+		// CLOCK_TAI is only usable when the host's time daemon sets its offset
+		{tai.ClockTAI, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.src.String(), func(t *testing.T) {
+			c, err := tai.NewClock(tc.src)
+			if err != nil {
+				if tc.required {
+					t.Fatal(err)
+				}
+				t.Skip(err)
+			}
+			if c.Source() != tc.src {
+				t.Fatalf("expected source %v, got %v", tc.src, c.Source())
+			}
+			now, first := tai.Now(), c.Now()
+			// one of the TTLs is zero, the other the distance between them
+			if d := tai.TTL(now, first) + tai.TTL(first, now); d > time.Second {
+				t.Fatalf("expected %v to be within 1 s of Now, %+v, got %+v", tc.src, now, first)
+			}
+			if second := c.Now(); second.Before(first) {
+				t.Fatalf("expected %v not to run backward, got %+v then %+v", tc.src, first, second)
+			}
+		})
+	}
+}
+
+func TestNewClockUnknown(t *testing.T) {
+	if _, err := tai.NewClock(tai.ClockSource(42)); err == nil {
+		t.Fatal("expected an error for an unknown clock source")
+	}
+	if s := tai.ClockSource(42).String(); s != "ClockSource(42)" {
+		t.Fatalf("expected ClockSource(42), got %s", s)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/brandondube/tai/bench"
+)
+
+func runBench(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "write the results as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: tai bench [-json] [case ...]")
+		fmt.Fprintln(fs.Output(), "\ncompares the speed of pkg tai with package time on the same operations.")
+		fmt.Fprintln(fs.Output(), "With no arguments, every case is run, for about two seconds each.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	cases := bench.Cases()
+	if fs.NArg() > 0 {
+		byName := map[string]bench.Case{}
+		for _, c := range cases {
+			byName[c.Name] = c
+		}
+		cases = cases[:0]
+		for _, name := range fs.Args() {
+			c, ok := byName[name]
+			if !ok {
+				return fmt.Errorf("unknown case %q", name)
+			}
+			cases = append(cases, c)
+		}
+	}
+	res := bench.Run(cases)
+	if *asJSON {
+		return bench.WriteJSON(os.Stdout, res)
+	}
+	return bench.WriteText(os.Stdout, res)
+}
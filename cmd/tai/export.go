@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/brandondube/tai"
+)
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "json", "format of the output: json, c, or python")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: tai export [-format FORMAT] > out")
+		fmt.Fprintln(fs.Output(), "\nwrites the leap second table as JSON, a C header, or a Python module, with")
+		fmt.Fprintln(fs.Output(), "its hash and expiry, so that programs in other languages use the same table.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	f, err := tai.ParseLeapTableFormat(*format)
+	if err != nil {
+		return err
+	}
+	return tai.WriteLeapTable(os.Stdout, f)
+}
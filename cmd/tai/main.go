@@ -0,0 +1,63 @@
+// Command tai provides utilities for working with International Atomic Time
+// and the leap second table of pkg tai.
+//
+// Usage:
+//
+//	tai <command> [arguments]
+//
+// The commands are:
+//
+//	bench        compare the speed of pkg tai with package time
+//	export       write the leap second table as JSON, C, or Python
+//	hash         print the identifier of the built-in leap second table
+//	restamp      correct TAI timestamps recorded with a stale leap second table
+//	tai64nlocal  convert TAI64N labels at the start of lines to readable times
+//
+// Use "tai <command> -h" for more information about a command.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name  string
+	short string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{"bench", "compare the speed of pkg tai with package time", runBench},
+	{"export", "write the leap second table as JSON, C, or Python", runExport},
+	{"hash", "print the identifier of the built-in leap second table", runHash},
+	{"restamp", "correct TAI timestamps recorded with a stale leap second table", runRestamp},
+	{"tai64nlocal", "convert TAI64N labels at the start of lines to readable times", runTAI64NLocal},
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: tai <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-13s%s\n", c.name, c.short)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	for _, c := range commands {
+		if c.name == os.Args[1] {
+			if err := c.run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "tai "+c.name+":", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "tai: unknown command %q\n", os.Args[1])
+	usage()
+	os.Exit(2)
+}
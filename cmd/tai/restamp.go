@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/brandondube/tai"
+)
+
+func runHash(args []string) error {
+	fs := flag.NewFlagSet("hash", flag.ExitOnError)
+	fs.Parse(args)
+	fmt.Println(tai.LeapTableHash())
+	return nil
+}
+
+func runRestamp(args []string) error {
+	fs := flag.NewFlagSet("restamp", flag.ExitOnError)
+	from := fs.String("from", "", "hash of the leap second table the input was recorded with (see tai hash)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: tai restamp -from HASH < in > out")
+		fmt.Fprintln(fs.Output(), "\nreads lines whose first field is a count of seconds since the TAI epoch,")
+		fmt.Fprintln(fs.Output(), "e.g. 2099347200.5, and rewrites that field as if it had been converted from")
+		fmt.Fprintln(fs.Output(), "UTC with the built-in leap second table.  The rest of each line is unchanged.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *from == "" {
+		fs.Usage()
+		return errors.New("-from is required")
+	}
+	old, ok := tai.FindLeapTable(*from)
+	if !ok {
+		return fmt.Errorf("unknown leap second table %s", *from)
+	}
+	return restamp(os.Stdin, os.Stdout, old)
+}
+
+func restamp(r io.Reader, w io.Writer, old []tai.LeapSecond) error {
+	sc := bufio.NewScanner(r)
+	bw := bufio.NewWriter(w)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		field := line
+		rest := ""
+		if i := strings.IndexAny(line, " \t,"); i >= 0 {
+			field, rest = line[:i], line[i:]
+		}
+		if field == "" {
+			fmt.Fprintln(bw, line)
+			continue
+		}
+		t, digits, err := parseSeconds(field)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n, err)
+		}
+		fmt.Fprintln(bw, formatSeconds(tai.Restamp(t, old), digits)+rest)
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// parseSeconds parses a decimal number of seconds since the TAI epoch with up
+// to 18 fractional digits, and returns the number of fractional digits
+func parseSeconds(s string) (tai.TAI, int, error) {
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	sec, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return tai.TAI{}, 0, fmt.Errorf("invalid seconds %q", s)
+	}
+	if len(frac) > 18 {
+		return tai.TAI{}, 0, fmt.Errorf("more than 18 fractional digits in %q", s)
+	}
+	var asec int64
+	if frac != "" {
+		asec, err = strconv.ParseInt(frac+strings.Repeat("0", 18-len(frac)), 10, 64)
+		if err != nil {
+			return tai.TAI{}, 0, fmt.Errorf("invalid fractional seconds %q", s)
+		}
+	}
+	if strings.HasPrefix(whole, "-") {
+		asec = -asec
+	}
+	return tai.Tai(sec, asec), len(frac), nil
+}
+
+// formatSeconds is the inverse of parseSeconds
+func formatSeconds(t tai.TAI, digits int) string {
+	sec, asec := t.Parts()
+	sign := ""
+	if sec < 0 && asec > 0 {
+		sign, sec, asec = "-", -(sec + 1), 1e18-asec
+	}
+	s := sign + strconv.FormatInt(sec, 10)
+	if digits == 0 {
+		return s
+	}
+	frac := fmt.Sprintf("%018d", asec)
+	return s + "." + frac[:digits]
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestRestampLines(t *testing.T) {
+	table := tai.LeapSeconds()
+	// 2020-01-01 recorded by a deployment that missed the leap second at the
+	// end of 2016 is one second short
+	sec, _ := tai.Unix(1577836800, 0).Parts()
+	in := strings.Join([]string{
+		formatSeconds(tai.Tai(sec-1, 25e16), 3) + " sensor=1",
+		"",
+		formatSeconds(tai.Tai(sec-1, 0), 0),
+	}, "\n")
+	exp := strings.Join([]string{
+		formatSeconds(tai.Tai(sec, 25e16), 3) + " sensor=1",
+		"",
+		formatSeconds(tai.Tai(sec, 0), 0),
+	}, "\n") + "\n"
+	var out bytes.Buffer
+	if err := restamp(strings.NewReader(in), &out, table[:len(table)-1]); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, out.String())
+	}
+}
+
+func TestSecondsRoundTrip(t *testing.T) {
+	for _, s := range []string{"0", "2099347200.5", "-1.25", "-0.000000000000000001", "12.000000000000000001"} {
+		ta, digits, err := parseSeconds(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := formatSeconds(ta, digits); got != s {
+			t.Errorf("expected %s, got %s", s, got)
+		}
+	}
+}
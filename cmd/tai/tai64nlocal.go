@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/brandondube/tai/taiio"
+)
+
+func runTAI64NLocal(args []string) error {
+	fs := flag.NewFlagSet("tai64nlocal", flag.ExitOnError)
+	utc := fs.Bool("utc", false, "print UTC instead of local time")
+	layout := fs.String("layout", taiio.LocalLayout, "layout of the printed times, in the notation of pkg time")
+	trueTAI := fs.Bool("tai", false, "read true TAI64N labels, as written by taiio's TAI64N stamp, rather than those of daemontools")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: tai tai64nlocal [-utc] [-tai] [-layout LAYOUT] < in > out")
+		fmt.Fprintln(fs.Output(), "\nreplaces the TAI64N label at the start of each line, as written by tai64n")
+		fmt.Fprintln(fs.Output(), "or multilog, with a human readable time.  Other lines are unchanged.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	d := taiio.Decoder{Layout: *layout, Libtai: !*trueTAI}
+	if *utc {
+		d.Location = time.UTC
+	}
+	return d.Decode(os.Stdout, os.Stdin)
+}
@@ -0,0 +1,70 @@
+package tai
+
+import (
+	"errors"
+	"math"
+)
+
+// CommonViewObservation is one station's observation of a reference event
+// that is also seen by another station, such as the arrival of a signal from
+// a GPS satellite, in common-view time transfer
+type CommonViewObservation struct {
+	// Local is the arrival of the reference event as stamped by the station's
+	// clock
+	Local TAI
+	// Delay is the modeled propagation delay from the reference to the
+	// station's clock, including geometric, atmospheric, antenna, and cable
+	// delays
+	Delay Duration
+	// Uncertainty is the standard uncertainty of Local less Delay
+	Uncertainty Duration
+}
+
+// offset returns the station's clock reading of the instant the reference
+// event was emitted
+func (o CommonViewObservation) offset() TAI {
+	return o.Local.AddDuration(o.Delay.Neg())
+}
+
+// CommonView returns the difference between the clocks of stations A and B,
+// clock A less clock B, from their observations of the same reference events.
+// a[i] and b[i] must observe the same event; the time of emission of the event
+// is common to both and cancels, so the reference need not be synchronized to
+// either station.
+//
+// offset is the mean of the per-event differences and is exact.  uncertainty
+// is the standard uncertainty of the mean, propagated from the stated
+// uncertainties of the observations; if there is more than one event and the
+// scatter of the differences indicates a larger uncertainty, the experimental
+// standard deviation of the mean is reported instead.
+func CommonView(a, b []CommonViewObservation) (offset, uncertainty Duration, err error) {
+	if len(a) != len(b) {
+		return Duration{}, Duration{}, errors.New("CommonView: stations have different numbers of observations")
+	}
+	if len(a) == 0 {
+		return Duration{}, Duration{}, errors.New("CommonView: no observations")
+	}
+	n := int64(len(a))
+	diffs := make([]Duration, len(a))
+	var sum Duration
+	var stated float64
+	for i := range a {
+		diffs[i] = sub(a[i].offset(), b[i].offset())
+		sum = sum.Add(diffs[i])
+		ua, ub := a[i].Uncertainty.Seconds(), b[i].Uncertainty.Seconds()
+		stated += ua*ua + ub*ub
+	}
+	offset = sum.div(n)
+	u := math.Sqrt(stated) / float64(n)
+	if n > 1 {
+		var ss float64
+		for _, d := range diffs {
+			r := d.Sub(offset).Seconds()
+			ss += r * r
+		}
+		if sem := math.Sqrt(ss / float64(n*(n-1))); sem > u {
+			u = sem
+		}
+	}
+	return offset, durationFromSeconds(u), nil
+}
@@ -0,0 +1,66 @@
+package tai_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCommonView(t *testing.T) {
+	emit := tai.Date(2024, 7, 1)
+	// clock A is 1 µs + 3 as ahead of clock B
+	ahead := tai.Dur(0, tai.Microsecond+3)
+	delayA := tai.Dur(0, 67*tai.Millisecond)
+	delayB := tai.Dur(0, 72*tai.Millisecond+11)
+	a := []tai.CommonViewObservation{{
+		Local:       emit.AddDuration(delayA).AddDuration(ahead),
+		Delay:       delayA,
+		Uncertainty: tai.Dur(0, 3*tai.Nanosecond),
+	}}
+	b := []tai.CommonViewObservation{{
+		Local:       emit.AddDuration(delayB),
+		Delay:       delayB,
+		Uncertainty: tai.Dur(0, 4*tai.Nanosecond),
+	}}
+	offset, u, err := tai.CommonView(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !offset.Eq(ahead) {
+		t.Errorf("expected offset %+v, got %+v", ahead, offset)
+	}
+	if math.Abs(u.Seconds()-5e-9) > 1e-15 {
+		t.Errorf("expected uncertainty 5 ns, got %v s", u.Seconds())
+	}
+}
+
+func TestCommonViewScatter(t *testing.T) {
+	emit := tai.Date(2024, 7, 1)
+	var a, b []tai.CommonViewObservation
+	// differences of 90 and 110 ns, with negligible stated uncertainty
+	for i, ns := range []int64{90, 110} {
+		e := emit.Add(int64(i)*16, 0)
+		a = append(a, tai.CommonViewObservation{Local: e.Add(0, ns*tai.Nanosecond)})
+		b = append(b, tai.CommonViewObservation{Local: e})
+	}
+	offset, u, err := tai.CommonView(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.Dur(0, 100*tai.Nanosecond); !offset.Eq(exp) {
+		t.Errorf("expected offset %+v, got %+v", exp, offset)
+	}
+	if math.Abs(u.Seconds()-10e-9) > 1e-15 {
+		t.Errorf("expected uncertainty 10 ns, got %v s", u.Seconds())
+	}
+}
+
+func TestCommonViewMismatched(t *testing.T) {
+	if _, _, err := tai.CommonView(make([]tai.CommonViewObservation, 2), make([]tai.CommonViewObservation, 1)); err == nil {
+		t.Error("expected an error for mismatched observations")
+	}
+	if _, _, err := tai.CommonView(nil, nil); err == nil {
+		t.Error("expected an error for no observations")
+	}
+}
@@ -0,0 +1,276 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Smear is a policy for leap seconds in UTC.  Rather than repeating a second,
+// some time sources (e.g. Google and AWS NTP) slew UTC across the day around
+// a leap second.  The Smear policy matches conversions to such a source.
+type Smear int
+
+const (
+	// SmearNone converts UTC with the leap second table exactly
+	SmearNone Smear = iota
+	// SmearLinear treats UTC as smeared linearly over the 24 hours from noon
+	// before to noon after each leap second, as done by Google and AWS
+	SmearLinear
+)
+
+var smearNames = [...]string{"none", "linear"}
+
+// String returns the name of s, e.g. linear
+func (s Smear) String() string {
+	if s < SmearNone || s > SmearLinear {
+		return fmt.Sprintf("Smear(%d)", int(s))
+	}
+	return smearNames[s]
+}
+
+// MarshalText implements encoding.TextMarshaler, using the names of String
+func (s Smear) MarshalText() ([]byte, error) {
+	if s < SmearNone || s > SmearLinear {
+		return nil, fmt.Errorf("Smear.MarshalText: unknown smear policy %d", int(s))
+	}
+	return []byte(smearNames[s]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Smear) UnmarshalText(b []byte) error {
+	v, err := parseSmear(string(b))
+	if err != nil {
+		return fmt.Errorf("Smear.UnmarshalText: %w", err)
+	}
+	*s = v
+	return nil
+}
+
+// Config holds the process-wide policies of pkg tai; see func Configure
+type Config struct {
+	// LeapSecondsFile is the leap-seconds.list file the table was last loaded
+	// from, if any
+	LeapSecondsFile string
+	// Strict rejects inputs that would otherwise be approximated: a leap
+	// second (:60) in a UTC timestamp is an error rather than being folded
+	// into the preceding second
+	Strict bool
+	// Smear is the leap second policy of UTC conversions
+	Smear Smear
+	// UpdateURLs are the sources of leap-seconds.list for an AutoUpdater
+	// without URLs of its own, in order of preference
+	UpdateURLs []string
+	// Locale holds the month and weekday names of Format and Parse
+	Locale Locale
+	// Layout is the format used where none is given, such as by a CSVWriter
+	// or CSVReader with an empty Layout
+	Layout string
+	// Clock is the source of the current time of DefaultClock
+	Clock Clock
+}
+
+// Option is a setting of Config, applied by func Configure
+type Option func(*Config) error
+
+// WithStrict sets Config.Strict
+func WithStrict(strict bool) Option {
+	return func(c *Config) error {
+		c.Strict = strict
+		return nil
+	}
+}
+
+// WithSmear sets Config.Smear
+func WithSmear(s Smear) Option {
+	return func(c *Config) error {
+		if s < SmearNone || s > SmearLinear {
+			return fmt.Errorf("unknown smear policy %v", s)
+		}
+		c.Smear = s
+		return nil
+	}
+}
+
+// WithUpdateURLs sets Config.UpdateURLs
+func WithUpdateURLs(urls ...string) Option {
+	return func(c *Config) error {
+		c.UpdateURLs = append([]string(nil), urls...)
+		return nil
+	}
+}
+
+// WithLocale sets Config.Locale; every name must be non-empty, though the
+// ordinal suffixes may be empty
+func WithLocale(l Locale) Option {
+	return func(c *Config) error {
+		for _, names := range [][]string{l.Months[:], l.MonthsAbbrev[:], l.Weekdays[:], l.WeekdaysAbbrev[:], l.Eras[:]} {
+			for _, n := range names {
+				if n == "" {
+					return errors.New("locale has an empty name")
+				}
+			}
+		}
+		c.Locale = l
+		return nil
+	}
+}
+
+// WithLayout sets Config.Layout, which must not be empty
+func WithLayout(layout string) Option {
+	return func(c *Config) error {
+		if layout == "" {
+			return errors.New("empty layout")
+		}
+		c.Layout = layout
+		return nil
+	}
+}
+
+// WithClock sets Config.Clock; nil restores SystemClock
+func WithClock(clock Clock) Option {
+	return func(c *Config) error {
+		if clock == nil {
+			clock = SystemClock
+		}
+		if _, ok := clock.(defaultClock); ok {
+			return errors.New("DefaultClock can not be its own source")
+		}
+		c.Clock = clock
+		return nil
+	}
+}
+
+var (
+	config   atomic.Value // *Config
+	configMu sync.Mutex   // serializes updates to config
+)
+
+func init() {
+	config.Store(&Config{Locale: English, Layout: RFC3339Nano, Clock: SystemClock})
+}
+
+// currentConfig returns the current configuration, which must not be modified
+func currentConfig() *Config {
+	return config.Load().(*Config)
+}
+
+func (c *Config) locale() *Locale {
+	return &c.Locale
+}
+
+// layoutOr returns layout, or Config.Layout if it is empty
+func layoutOr(layout string) string {
+	if layout == "" {
+		return currentConfig().Layout
+	}
+	return layout
+}
+
+// GetConfig returns a copy of the current configuration
+func GetConfig() Config {
+	c := *currentConfig()
+	c.UpdateURLs = append([]string(nil), c.UpdateURLs...)
+	return c
+}
+
+// Configure applies opts to the configuration of pkg tai.  Options are
+// applied in order, and the new configuration takes effect at once for all
+// goroutines; Configure may be called concurrently with itself and with any
+// other function of the package.  If any option is invalid, an error is
+// returned and no setting is changed.
+func Configure(opts ...Option) error {
+	if err := configure(opts); err != nil {
+		return fmt.Errorf("Configure: %w", err)
+	}
+	return nil
+}
+
+func configure(opts []Option) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+	c := *currentConfig()
+	for _, o := range opts {
+		if err := o(&c); err != nil {
+			return err
+		}
+	}
+	config.Store(&c)
+	return nil
+}
+
+// DefaultClock is the Clock of Config.Clock, SystemClock unless changed with
+// WithClock.  It is used where a nil Clock is given, and follows any later
+// change to the configuration.
+var DefaultClock Clock = defaultClock{}
+
+type defaultClock struct{}
+
+func (defaultClock) Now() TAI {
+	return currentConfig().Clock.Now()
+}
+
+// The environment variables read by ConfigureFromEnv
+const (
+	StrictEnv     = "TAI_STRICT"
+	SmearEnv      = "TAI_SMEAR"
+	UpdateURLsEnv = "TAI_UPDATE_URLS"
+)
+
+// ConfigureFromEnv configures pkg tai from the environment, so that a
+// deployment may be configured without code changes:
+//
+//	TAI_LEAPSECONDS_FILE  leap-seconds.list file to load; see LeapSecondsFileEnv
+//	TAI_STRICT            Config.Strict, a boolean such as 1 or false
+//	TAI_SMEAR             Config.Smear, "none" or "linear"
+//	TAI_UPDATE_URLS       Config.UpdateURLs, separated by commas or spaces
+//
+// unset variables leave their setting unchanged.  If any variable is invalid,
+// an error is returned and no setting is changed, except that the leap second
+// file is loaded first and applies even if a later variable is invalid.
+func ConfigureFromEnv() error {
+	var opts []Option
+	if path := os.Getenv(LeapSecondsFileEnv); path != "" {
+		if err := loadLeapSecondsFile(path); err != nil {
+			return fmt.Errorf("ConfigureFromEnv: %s: %w", LeapSecondsFileEnv, err)
+		}
+		opts = append(opts, func(c *Config) error {
+			c.LeapSecondsFile = path
+			return nil
+		})
+	}
+	if v := os.Getenv(StrictEnv); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("ConfigureFromEnv: %s: invalid boolean %q", StrictEnv, v)
+		}
+		opts = append(opts, WithStrict(b))
+	}
+	if v := os.Getenv(SmearEnv); v != "" {
+		s, err := parseSmear(v)
+		if err != nil {
+			return fmt.Errorf("ConfigureFromEnv: %s: %w", SmearEnv, err)
+		}
+		opts = append(opts, WithSmear(s))
+	}
+	if v := os.Getenv(UpdateURLsEnv); v != "" {
+		opts = append(opts, WithUpdateURLs(strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' })...))
+	}
+	if err := configure(opts); err != nil {
+		return fmt.Errorf("ConfigureFromEnv: %w", err)
+	}
+	return nil
+}
+
+func parseSmear(s string) (Smear, error) {
+	for i, n := range smearNames {
+		if strings.EqualFold(s, n) {
+			return Smear(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown smear policy %q", s)
+}
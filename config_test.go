@@ -0,0 +1,232 @@
+package tai_test
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+// withEnv runs f with the environment variables kv set, then restores the
+// default configuration
+func withEnv(t *testing.T, kv map[string]string, f func()) {
+	t.Helper()
+	for k, v := range kv {
+		os.Setenv(k, v)
+	}
+	defer func() {
+		for k := range kv {
+			os.Unsetenv(k)
+		}
+		os.Setenv(tai.SmearEnv, "none")
+		os.Setenv(tai.StrictEnv, "false")
+		if err := tai.ConfigureFromEnv(); err != nil {
+			t.Fatal(err)
+		}
+		os.Unsetenv(tai.SmearEnv)
+		os.Unsetenv(tai.StrictEnv)
+	}()
+	f()
+}
+
+func TestConfigureFromEnvInvalid(t *testing.T) {
+	cases := []struct {
+		descr string
+		kv    map[string]string
+	}{
+		{"Strict", map[string]string{tai.StrictEnv: "maybe"}},
+		{"Smear", map[string]string{tai.SmearEnv: "quadratic"}},
+		{"LeapFile", map[string]string{tai.LeapSecondsFileEnv: "/does/not/exist"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			withEnv(t, tc.kv, func() {
+				if err := tai.ConfigureFromEnv(); err == nil {
+					t.Fatal("expected an error")
+				}
+			})
+		})
+	}
+}
+
+func TestConfigureFromEnvStrict(t *testing.T) {
+	const leap = "2016-12-31T23:59:60Z"
+	withEnv(t, map[string]string{tai.StrictEnv: "1"}, func() {
+		if err := tai.ConfigureFromEnv(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tai.ParseTemporalInstant(leap); err == nil {
+			t.Fatal("expected strict mode to reject a leap second")
+		}
+	})
+	if _, err := tai.ParseTemporalInstant(leap); err != nil {
+		t.Fatalf("expected the leap second to be accepted outside of strict mode, got %v", err)
+	}
+}
+
+func TestConfigureFromEnvSmear(t *testing.T) {
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	exact := tai.Unix(leap, 0)
+	withEnv(t, map[string]string{tai.SmearEnv: "linear"}, func() {
+		if err := tai.ConfigureFromEnv(); err != nil {
+			t.Fatal(err)
+		}
+		cases := []struct {
+			descr  string
+			unix   int64
+			offset tai.TAI // the expected TAI time, relative to the unsmeared instant
+		}{
+			{"BeforeSmear", leap - 12*tai.Hour - 1, tai.Unix(leap-12*tai.Hour-1, 0)},
+			{"SmearStart", leap - 12*tai.Hour, tai.Unix(leap-12*tai.Hour, 0)},
+			{"Midpoint", leap, exact.Add(-1, 5e17)},
+			{"SmearEnd", leap + 12*tai.Hour, tai.Unix(leap+12*tai.Hour, 0)},
+		}
+		for _, tc := range cases {
+			t.Run(tc.descr, func(t *testing.T) {
+				got := tai.Unix(tc.unix, 0)
+				if !got.Eq(tc.offset) {
+					t.Fatalf("expected %+v, got %+v", tc.offset, got)
+				}
+				if s, ns := got.Unix(); s != tc.unix || ns != 0 {
+					t.Fatalf("expected UNIX time %d, got %d.%09d", tc.unix, s, ns)
+				}
+			})
+		}
+	})
+	// the unsmeared conversion has a whole second step at the leap
+	if got := tai.Unix(leap, 0); !got.Eq(exact) {
+		t.Fatalf("expected the smear to be disabled, got %+v", got)
+	}
+}
+
+// restoreConfig undoes the settings changed by the Configure tests
+func restoreConfig(t *testing.T) {
+	t.Helper()
+	err := tai.Configure(tai.WithStrict(false), tai.WithSmear(tai.SmearNone), tai.WithLocale(tai.English), tai.WithLayout(tai.RFC3339Nano), tai.WithClock(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetConfigDefaults(t *testing.T) {
+	c := tai.GetConfig()
+	if c.Strict || c.Smear != tai.SmearNone || c.Layout != tai.RFC3339Nano || c.Clock != tai.SystemClock || c.Locale != tai.English {
+		t.Fatalf("unexpected default configuration %+v", c)
+	}
+}
+
+func TestConfigureInvalid(t *testing.T) {
+	defer restoreConfig(t)
+	cases := []struct {
+		descr string
+		opt   tai.Option
+	}{
+		{"Smear", tai.WithSmear(7)},
+		{"Layout", tai.WithLayout("")},
+		{"Locale", tai.WithLocale(tai.Locale{})},
+		{"Clock", tai.WithClock(tai.DefaultClock)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if err := tai.Configure(tai.WithStrict(true), tc.opt); err == nil {
+				t.Fatal("expected an error")
+			}
+			if tai.GetConfig().Strict {
+				t.Fatal("a setting changed despite the error")
+			}
+		})
+	}
+}
+
+func TestConfigureLocale(t *testing.T) {
+	defer restoreConfig(t)
+	fr := tai.English
+	fr.Months[tai.July-1], fr.MonthsAbbrev[tai.July-1] = "juillet", "juil."
+	fr.Weekdays[tai.Monday], fr.WeekdaysAbbrev[tai.Monday] = "lundi", "lun."
+	if err := tai.Configure(tai.WithLocale(fr)); err != nil {
+		t.Fatal(err)
+	}
+	const layout = "%a %A %d %b %B %Y"
+	ta := tai.Date(2024, 7, 1)
+	s := ta.Format(layout)
+	if exp := "lun. lundi 01 juil. juillet 2024"; s != exp {
+		t.Fatalf("expected %q, got %q", exp, s)
+	}
+	back, err := tai.Parse(layout, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !back.Eq(ta) {
+		t.Fatalf("expected %+v, got %+v", ta.AsGregorian(), back.AsGregorian())
+	}
+}
+
+func TestConfigureLayout(t *testing.T) {
+	defer restoreConfig(t)
+	if err := tai.Configure(tai.WithLayout(tai.RFC3339)); err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	w := tai.NewCSVWriter(&b, "")
+	ta := tai.Date(2024, 7, 1).AddHMS(1, 2, 3)
+	if err := w.WriteRow(ta); err != nil {
+		t.Fatal(err)
+	}
+	w.Flush()
+	if exp := "2024-07-01T01:02:03Z\n"; b.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, b.String())
+	}
+	row, err := tai.NewCSVReader(strings.NewReader(b.String()), "").Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !row[0].Eq(ta) {
+		t.Fatalf("expected %+v, got %+v", ta, row[0])
+	}
+}
+
+func TestConfigureClock(t *testing.T) {
+	defer restoreConfig(t)
+	clock := &fakeClock{now: tai.Date(2024, 7, 1)}
+	if err := tai.Configure(tai.WithClock(clock)); err != nil {
+		t.Fatal(err)
+	}
+	if now := tai.DefaultClock.Now(); !now.Eq(clock.now) {
+		t.Fatalf("expected %+v, got %+v", clock.now, now)
+	}
+	if tai.GetConfig().Clock != clock {
+		t.Fatal("expected GetConfig to report the configured clock")
+	}
+}
+
+func TestConfigureConcurrent(t *testing.T) {
+	defer restoreConfig(t)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tai.Configure(tai.WithStrict(j%2 == 0), tai.WithUpdateURLs("https://example.com/"+strconv.Itoa(i)))
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c := tai.GetConfig()
+				c.UpdateURLs = append(c.UpdateURLs[:0], "modified")
+				_ = tai.Date(2024, 7, 1).Format("%B")
+			}
+		}()
+	}
+	wg.Wait()
+	for _, u := range tai.GetConfig().UpdateURLs {
+		if u == "modified" {
+			t.Fatal("a copy returned by GetConfig aliased the configuration")
+		}
+	}
+}
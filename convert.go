@@ -0,0 +1,129 @@
+package tai
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrLeapSecond is returned by a strict conversion of an instant within an
+	// inserted leap second, which has no representation as a UNIX time
+	ErrLeapSecond = errors.New("tai: instant is within a leap second")
+	// ErrSkippedSecond is returned by a strict conversion of a UTC time within
+	// the second omitted by a negative leap second, 23:59:59, which does not
+	// exist
+	ErrSkippedSecond = errors.New("tai: UTC time was omitted by a negative leap second")
+	// ErrLeapTableExpired is returned by a strict conversion of an instant
+	// after the expiration of the leap second table, when a leap second not
+	// yet in the table may have occurred
+	ErrLeapTableExpired = errors.New("tai: instant is after the leap second table expires")
+)
+
+// ConvertOptions are the policies of a single conversion between TAI and UTC,
+// for code that must not depend on or change the process-wide Config.
+type ConvertOptions struct {
+	// Smear is the leap second policy of the conversion
+	Smear Smear
+	// Strict makes approximations errors: an instant within a leap second
+	// (unless smeared) is ErrLeapSecond, a UTC time omitted by a negative leap
+	// second (unless smeared) is ErrSkippedSecond, and an instant after the
+	// expiration of the leap second table is ErrLeapTableExpired
+	Strict bool
+}
+
+// DefaultConvertOptions returns the ConvertOptions of the conversions of
+// Unix and AsTime: the Smear of the current Config, and not Strict.
+// Config.Strict, which governs the parsing of :60 in timestamps, does not
+// apply.
+func DefaultConvertOptions() ConvertOptions {
+	return ConvertOptions{Smear: currentConfig().Smear}
+}
+
+// UnixOpts is Unix with the policies of o rather than of the Config
+func UnixOpts(seconds, nsec int64, o ConvertOptions) (TAI, error) {
+	if o.Strict && o.Smear == SmearNone && inSkippedSecond(seconds) {
+		return TAI{}, ErrSkippedSecond
+	}
+	if err := o.checkExpiry(seconds); err != nil {
+		return TAI{}, err
+	}
+	return unixAsecWith(seconds, nsec*Nanosecond, o.Smear), nil
+}
+
+// UnixOpts is func (TAI) Unix with the policies of o rather than of the Config
+func (t TAI) UnixOpts(o ConvertOptions) (secs, nsecs int64, err error) {
+	if o.Strict && o.Smear == SmearNone && inLeapSecond(t.sec-unixEpochSkew) {
+		return 0, 0, ErrLeapSecond
+	}
+	secs, asecs := t.unixWith(o.Smear)
+	if err := o.checkExpiry(secs); err != nil {
+		return 0, 0, err
+	}
+	return secs, asecs / Nanosecond, nil
+}
+
+// FromTimeOpts is FromTime with the policies of o rather than of the Config
+func FromTimeOpts(t time.Time, o ConvertOptions) (TAI, error) {
+	return UnixOpts(t.Unix(), int64(t.Nanosecond()), o)
+}
+
+// AsTimeOpts is AsTime with the policies of o rather than of the Config
+func (t TAI) AsTimeOpts(o ConvertOptions) (time.Time, error) {
+	s, ns, err := t.UnixOpts(o)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(s, ns).UTC(), nil
+}
+
+// checkExpiry returns ErrLeapTableExpired if o is strict and the UNIX time
+// secs is at or after the table's expiration
+func (o ConvertOptions) checkExpiry(secs int64) error {
+	if !o.Strict {
+		return nil
+	}
+	if exp := atomic.LoadInt64(&leapExpires); exp != 0 && secs >= exp {
+		return ErrLeapTableExpired
+	}
+	return nil
+}
+
+// inLeapSecond returns true if the TAI time s, in seconds since the UNIX
+// epoch, is within an inserted leap second
+func inLeapSecond(s int64) bool {
+	leaplock.RLock()
+	defer leaplock.RUnlock()
+	return inLeapSecondIn(leaps, s)
+}
+
+// inLeapSecondIn is inLeapSecond for an arbitrary table
+func inLeapSecondIn(leaps []leap, s int64) bool {
+	for i := len(leaps) - 1; i >= 1; i-- {
+		l, old := leaps[i], leaps[i-1].CumulativeSkew
+		if s >= l.UnixUTC+l.CumulativeSkew {
+			return false
+		}
+		if s >= l.UnixUTC+old {
+			return l.CumulativeSkew > old
+		}
+	}
+	return false
+}
+
+// inSkippedSecond returns true if the UNIX time s is within a second omitted
+// by a negative leap second
+func inSkippedSecond(s int64) bool {
+	leaplock.RLock()
+	defer leaplock.RUnlock()
+	for i := len(leaps) - 1; i >= 1; i-- {
+		l, old := leaps[i], leaps[i-1].CumulativeSkew
+		if s >= l.UnixUTC {
+			return false
+		}
+		if step := l.CumulativeSkew - old; step < 0 && s >= l.UnixUTC+step {
+			return true
+		}
+	}
+	return false
+}
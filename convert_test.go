@@ -0,0 +1,96 @@
+package tai_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestUnixOptsLeapSecond(t *testing.T) {
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	// the inserted second, 23:59:60
+	inLeap := tai.Unix(leap-1, 0).Add(1, 0)
+	cases := []struct {
+		descr  string
+		t      tai.TAI
+		opts   tai.ConvertOptions
+		exp    int64
+		expErr error
+	}{
+		{"Lenient", inLeap, tai.ConvertOptions{}, leap, nil},
+		{"Strict", inLeap, tai.ConvertOptions{Strict: true}, 0, tai.ErrLeapSecond},
+		{"StrictBefore", inLeap.Add(0, -1), tai.ConvertOptions{Strict: true}, leap - 1, nil},
+		{"StrictAfter", inLeap.Add(1, 0), tai.ConvertOptions{Strict: true}, leap, nil},
+		{"StrictSmeared", inLeap, tai.ConvertOptions{Strict: true, Smear: tai.SmearLinear}, leap - 1, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			s, _, err := tc.t.UnixOpts(tc.opts)
+			if !errors.Is(err, tc.expErr) {
+				t.Fatalf("expected error %v, got %v", tc.expErr, err)
+			}
+			if s != tc.exp {
+				t.Fatalf("expected %d, got %d", tc.exp, s)
+			}
+		})
+	}
+}
+
+func TestConvertOptsExpired(t *testing.T) {
+	future := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	strict := tai.ConvertOptions{Strict: true}
+	if _, err := tai.FromTimeOpts(future, strict); !errors.Is(err, tai.ErrLeapTableExpired) {
+		t.Fatalf("expected ErrLeapTableExpired, got %v", err)
+	}
+	ta, err := tai.FromTimeOpts(future, tai.ConvertOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ta.AsTimeOpts(strict); !errors.Is(err, tai.ErrLeapTableExpired) {
+		t.Fatalf("expected ErrLeapTableExpired, got %v", err)
+	}
+	past := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ta, err = tai.FromTimeOpts(past, strict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back, err := ta.AsTimeOpts(strict); err != nil || !back.Equal(past) {
+		t.Fatalf("expected %v, got %v (%v)", past, back, err)
+	}
+}
+
+func TestConvertOptsIndependentOfConfig(t *testing.T) {
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	exp := tai.Unix(leap, 0)
+	defer restoreConfig(t)
+	if err := tai.Configure(tai.WithSmear(tai.SmearLinear)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := tai.UnixOpts(leap, 0, tai.ConvertOptions{Smear: tai.SmearNone})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if smeared := tai.Unix(leap, 0); got.Eq(smeared) {
+		t.Fatal("expected the per-call smear policy to differ from the configured one")
+	}
+	if !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+	if o := tai.DefaultConvertOptions(); o.Smear != tai.SmearLinear {
+		t.Fatalf("expected the configured smear policy, got %v", o.Smear)
+	}
+}
+
+func TestDefaultConvertOptionsStrict(t *testing.T) {
+	defer restoreConfig(t)
+	if err := tai.Configure(tai.WithStrict(true)); err != nil {
+		t.Fatal(err)
+	}
+	if o := tai.DefaultConvertOptions(); o.Strict {
+		t.Fatal("expected Config.Strict not to make the conversion options strict")
+	}
+}
@@ -0,0 +1,55 @@
+package tai
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// EncodeCorpus returns a compact binary encoding of t for the seed corpus of
+// a fuzz test, e.g. f.Add(tai.EncodeCorpus(t)).  It is the zig-zag varint of
+// the seconds of t followed by the varint of its attoseconds, at most 19
+// bytes.
+func EncodeCorpus(t TAI) []byte {
+	b := make([]byte, 0, 2*binary.MaxVarintLen64)
+	b = appendVarint(b, t.sec)
+	return appendUvarint(b, uint64(t.asec))
+}
+
+// DecodeCorpus decodes the output of EncodeCorpus.  Every byte string decodes
+// either to an error or to a valid TAI, so a fuzz target may turn its input
+// into a TAI with
+//
+//	t, err := tai.DecodeCorpus(data)
+//	if err != nil {
+//		return
+//	}
+//
+// and any crash is reproducible from the corpus entry.  Only the canonical
+// encoding of each TAI is accepted, so each decodes from exactly one string.
+func DecodeCorpus(b []byte) (TAI, error) {
+	sec, n := binary.Varint(b)
+	if n <= 0 || n != len(appendVarint(nil, sec)) {
+		return TAI{}, errors.New("DecodeCorpus: invalid seconds")
+	}
+	asec, m := binary.Uvarint(b[n:])
+	if m <= 0 || m != len(appendUvarint(nil, asec)) {
+		return TAI{}, errors.New("DecodeCorpus: invalid attoseconds")
+	}
+	if asec >= 1e18 {
+		return TAI{}, errors.New("DecodeCorpus: attoseconds out of range")
+	}
+	if n+m != len(b) {
+		return TAI{}, errors.New("DecodeCorpus: unexpected trailing bytes")
+	}
+	return TAI{sec: sec, asec: int64(asec)}, nil
+}
+
+func appendVarint(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutVarint(buf[:], v)]...)
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
@@ -0,0 +1,65 @@
+package tai_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"testing/quick"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCorpusRoundTrip(t *testing.T) {
+	for _, ta := range []tai.TAI{{}, tai.Tai(-1, 1), tai.Tai(math.MaxInt64, 1e18-1), tai.Tai(math.MinInt64, 0)} {
+		got, err := tai.DecodeCorpus(tai.EncodeCorpus(ta))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Eq(ta) {
+			t.Fatalf("expected %+v, got %+v", ta, got)
+		}
+	}
+	if n := len(tai.EncodeCorpus(tai.Tai(math.MinInt64, 1e18-1))); n > 19 {
+		t.Fatalf("expected at most 19 bytes, got %d", n)
+	}
+}
+
+func uvarintAfterZero(v uint64) []byte {
+	b := make([]byte, 1+binary.MaxVarintLen64)
+	return b[:1+binary.PutUvarint(b[1:], v)]
+}
+
+func TestDecodeCorpusInvalid(t *testing.T) {
+	cases := []struct {
+		descr string
+		in    []byte
+	}{
+		{"Empty", nil},
+		{"MissingAttoseconds", []byte{2}},
+		{"Trailing", []byte{2, 0, 0}},
+		{"NonCanonical", []byte{0x82, 0x00, 0}},
+		{"AttosecondsOutOfRange", uvarintAfterZero(1e18)},
+		{"Truncated", []byte{0xff}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if _, err := tai.DecodeCorpus(tc.in); err == nil {
+				t.Fatalf("expected error decoding %x", tc.in)
+			}
+		})
+	}
+}
+
+func TestDecodeCorpusAnyBytes(t *testing.T) {
+	f := func(b []byte) bool {
+		ta, err := tai.DecodeCorpus(b)
+		if err != nil {
+			return true
+		}
+		_, asec := ta.Parts()
+		return asec >= 0 && asec < 1e18 && string(tai.EncodeCorpus(ta)) == string(b)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
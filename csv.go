@@ -0,0 +1,202 @@
+package tai
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// CSVWriter writes rows of TAI values as CSV (or TSV) records, formatting each
+// value with Layout.  Rows of TAI values are written without allocating.
+type CSVWriter struct {
+	// Comma is the field delimiter, ',' by default; use '\t' for TSV.  As for
+	// encoding/csv, it must be a valid rune other than a quote, CR, or LF.
+	Comma rune
+	// Layout is the format of each value (see func Format), and so determines
+	// its precision, e.g. RFC3339 for whole seconds or RFC3339Nano for
+	// nanoseconds.  If empty, Config.Layout is used.
+	Layout string
+
+	w   *bufio.Writer
+	buf []byte
+}
+
+// NewCSVWriter returns a CSVWriter that writes to w using layout
+func NewCSVWriter(w io.Writer, layout string) *CSVWriter {
+	return &CSVWriter{Comma: ',', Layout: layout, w: bufio.NewWriter(w)}
+}
+
+// WriteHeader writes a record of column names
+func (c *CSVWriter) WriteHeader(names ...string) error {
+	c.buf = c.buf[:0]
+	for i, n := range names {
+		if i > 0 {
+			c.buf = c.appendComma(c.buf)
+		}
+		c.buf = c.appendField(c.buf, []byte(n))
+	}
+	return c.writeRecord()
+}
+
+// WriteRow writes one record containing the values of row
+func (c *CSVWriter) WriteRow(row ...TAI) error {
+	c.buf = c.buf[:0]
+	layout := layoutOr(c.Layout)
+	var field []byte
+	for i, t := range row {
+		if i > 0 {
+			c.buf = c.appendComma(c.buf)
+		}
+		start := len(c.buf)
+		c.buf = t.AppendFormat(c.buf, layout)
+		if c.needsQuotes(c.buf[start:]) {
+			field = append(field[:0], c.buf[start:]...)
+			c.buf = c.appendField(c.buf[:start], field)
+		}
+	}
+	return c.writeRecord()
+}
+
+// WriteColumns writes len(cols[0]) records, the i-th containing the i-th
+// value of each column.  All columns must be the same length.
+func (c *CSVWriter) WriteColumns(cols ...[]TAI) error {
+	if len(cols) == 0 {
+		return nil
+	}
+	n := len(cols[0])
+	for _, col := range cols {
+		if len(col) != n {
+			return fmt.Errorf("WriteColumns: columns have different lengths %d and %d", n, len(col))
+		}
+	}
+	row := make([]TAI, len(cols))
+	for i := 0; i < n; i++ {
+		for j, col := range cols {
+			row[j] = col[i]
+		}
+		if err := c.WriteRow(row...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered data to the underlying io.Writer
+func (c *CSVWriter) Flush() error {
+	return c.w.Flush()
+}
+
+func (c *CSVWriter) writeRecord() error {
+	c.buf = append(c.buf, '\n')
+	_, err := c.w.Write(c.buf)
+	return err
+}
+
+func (c *CSVWriter) appendComma(b []byte) []byte {
+	if c.Comma < utf8.RuneSelf {
+		return append(b, byte(c.Comma))
+	}
+	var enc [utf8.UTFMax]byte
+	n := utf8.EncodeRune(enc[:], c.Comma)
+	return append(b, enc[:n]...)
+}
+
+func (c *CSVWriter) needsQuotes(field []byte) bool {
+	if bytes.ContainsRune(field, c.Comma) || bytes.ContainsAny(field, "\"\r\n") {
+		return true
+	}
+	return len(field) > 0 && field[0] == ' '
+}
+
+// appendField appends field to b, quoted if necessary
+func (c *CSVWriter) appendField(b, field []byte) []byte {
+	if !c.needsQuotes(field) {
+		return append(b, field...)
+	}
+	b = append(b, '"')
+	for _, r := range field {
+		if r == '"' {
+			b = append(b, '"')
+		}
+		b = append(b, r)
+	}
+	return append(b, '"')
+}
+
+// CSVReader reads records of TAI values written by a CSVWriter with the same
+// Layout
+type CSVReader struct {
+	// Comma is the field delimiter, ',' by default
+	Comma rune
+	// Layout is the format of each value; see func Parse.  If empty,
+	// Config.Layout is used.
+	Layout string
+
+	r   *csv.Reader
+	rec int
+}
+
+// NewCSVReader returns a CSVReader that reads from r using layout
+func NewCSVReader(r io.Reader, layout string) *CSVReader {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	return &CSVReader{Comma: ',', Layout: layout, r: cr}
+}
+
+// ReadHeader reads a record of column names
+func (c *CSVReader) ReadHeader() ([]string, error) {
+	rec, err := c.read()
+	if err != nil {
+		return nil, err
+	}
+	return append([]string(nil), rec...), nil
+}
+
+// Read reads one record and parses each of its fields.  At the end of the
+// input, Read returns nil, io.EOF.
+func (c *CSVReader) Read() ([]TAI, error) {
+	rec, err := c.read()
+	if err != nil {
+		return nil, err
+	}
+	row := make([]TAI, len(rec))
+	layout := layoutOr(c.Layout)
+	for i, f := range rec {
+		row[i], err = Parse(layout, strings.TrimSpace(f))
+		if err != nil {
+			return nil, fmt.Errorf("CSVReader: record %d, field %d: %w", c.rec, i+1, err)
+		}
+	}
+	return row, nil
+}
+
+// ReadColumns reads all remaining records, returning the values of each
+// column.  Every record must have the same number of fields.
+func (c *CSVReader) ReadColumns() ([][]TAI, error) {
+	var cols [][]TAI
+	for {
+		row, err := c.Read()
+		if err == io.EOF {
+			return cols, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if cols == nil {
+			cols = make([][]TAI, len(row))
+		}
+		for j := range row {
+			cols[j] = append(cols[j], row[j])
+		}
+	}
+}
+
+func (c *CSVReader) read() ([]string, error) {
+	c.r.Comma = c.Comma
+	c.rec++
+	return c.r.Read()
+}
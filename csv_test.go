@@ -0,0 +1,89 @@
+package tai_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	a := []tai.TAI{tai.Date(2024, 1, 1).Add(0, 5*tai.Nanosecond), tai.Date(2024, 1, 2).AddHMS(3, 4, 5)}
+	b := []tai.TAI{tai.Date(1958, 1, 1), tai.Date(1900, 12, 31).AddHMS(23, 59, 59)}
+	for _, comma := range []rune{',', '\t', '¦'} {
+		var buf bytes.Buffer
+		w := tai.NewCSVWriter(&buf, tai.RFC3339Nano)
+		w.Comma = comma
+		if err := w.WriteHeader("a", "b"); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.WriteColumns(a, b); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		r := tai.NewCSVReader(strings.NewReader(buf.String()), tai.RFC3339Nano)
+		r.Comma = comma
+		hdr, err := r.ReadHeader()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(hdr, ",") != "a,b" {
+			t.Fatalf("expected header a,b, got %v", hdr)
+		}
+		cols, err := r.ReadColumns()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cols) != 2 || len(cols[0]) != 2 {
+			t.Fatalf("expected two columns of two values, got %v", cols)
+		}
+		for i := range a {
+			if !cols[0][i].Eq(a[i]) || !cols[1][i].Eq(b[i]) {
+				t.Fatalf("row %d did not round trip through %q", i, buf.String())
+			}
+		}
+	}
+}
+
+func TestCSVWriterQuotes(t *testing.T) {
+	var buf bytes.Buffer
+	w := tai.NewCSVWriter(&buf, "%B %d, %Y")
+	w.WriteHeader("when, exactly", "x")
+	w.WriteRow(tai.Date(2024, 7, 4), tai.Date(2024, 7, 5))
+	w.Flush()
+	exp := "\"when, exactly\",x\n\"July 04, 2024\",\"July 05, 2024\"\n"
+	if buf.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, buf.String())
+	}
+	r := tai.NewCSVReader(strings.NewReader(buf.String()), "%B %d, %Y")
+	r.ReadHeader()
+	row, err := r.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !row[1].Eq(tai.Date(2024, 7, 5)) {
+		t.Fatalf("expected July 5, got %+v", row[1].AsGregorian())
+	}
+}
+
+func TestCSVReaderError(t *testing.T) {
+	r := tai.NewCSVReader(strings.NewReader("2024-01-01T00:00:00Z,garbage\n"), tai.RFC3339)
+	if _, err := r.Read(); err == nil || !strings.Contains(err.Error(), "field 2") {
+		t.Fatalf("expected an error locating field 2, got %v", err)
+	}
+}
+
+func TestCSVWriterDoesNotAllocate(t *testing.T) {
+	w := tai.NewCSVWriter(&bytes.Buffer{}, tai.RFC3339Nano)
+	row := []tai.TAI{tai.Date(2024, 1, 1), tai.Date(2024, 1, 2)}
+	w.WriteRow(row...)
+	allocs := testing.AllocsPerRun(100, func() {
+		w.WriteRow(row...)
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations, got %v", allocs)
+	}
+}
@@ -0,0 +1,128 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// time code identifications of the CUC P-field
+const (
+	cucLevel1 = 1 // the TAI epoch
+	cucLevel2 = 2 // an agency-defined epoch
+)
+
+// ErrCUCRange is returned when encoding an instant before the epoch of a CUC
+// or too late for its basic time octets
+var ErrCUCRange = errors.New("tai: time out of range of the CCSDS unsegmented time code")
+
+// CUC is a CCSDS Unsegmented Time Code (CCSDS 301.0-B-4), a binary count of
+// seconds and binary fractions of a second since an epoch, as used by
+// spacecraft telemetry
+type CUC struct {
+	// Basic is the number of octets of whole seconds, 1 to 4
+	Basic int
+	// Fractional is the number of octets of the fraction of a second, 0 to 3
+	Fractional int
+	// Epoch is the origin of the count; the zero value is the TAI epoch,
+	// 1958-01-01, of a level 1 time code.  Any other is an agency-defined
+	// epoch, of level 2.
+	Epoch TAI
+}
+
+// check panics if the octet counts of c are not representable in a P-field
+func (c CUC) check() {
+	if c.Basic < 1 || c.Basic > 4 || c.Fractional < 0 || c.Fractional > 3 {
+		panic(fmt.Sprintf("tai.CUC: unsupported octet counts %d and %d", c.Basic, c.Fractional))
+	}
+}
+
+// Len returns the length of the T-field of c in octets
+func (c CUC) Len() int {
+	return c.Basic + c.Fractional
+}
+
+// PField returns the preamble field identifying c
+func (c CUC) PField() byte {
+	c.check()
+	id := byte(cucLevel1)
+	if !c.Epoch.IsZero() {
+		id = cucLevel2
+	}
+	return id<<4 | byte(c.Basic-1)<<2 | byte(c.Fractional)
+}
+
+// AppendTField appends the T-field of t, the time code without its preamble,
+// to b and returns the extended slice.  The fraction is truncated toward the
+// past.  ErrCUCRange is returned if t is before Epoch or after the greatest
+// count of Basic octets.  AppendTField panics if the octet counts are not
+// supported.
+func (c CUC) AppendTField(b []byte, t TAI) ([]byte, error) {
+	c.check()
+	d := t.Sub(c.Epoch)
+	if d.sec < 0 || d.sec >= 1<<(8*c.Basic) {
+		return b, ErrCUCRange
+	}
+	for i := c.Basic - 1; i >= 0; i-- {
+		b = append(b, byte(d.sec>>(8*i)))
+	}
+	// the fraction is asec * 2^(8*Fractional) / 1e18
+	frac := new(big.Int).Lsh(big.NewInt(d.asec), uint(8*c.Fractional))
+	f := frac.Div(frac, bigAsec).Uint64()
+	for i := c.Fractional - 1; i >= 0; i-- {
+		b = append(b, byte(f>>(8*i)))
+	}
+	return b, nil
+}
+
+// Append appends the P-field and T-field of t to b; see func AppendTField
+func (c CUC) Append(b []byte, t TAI) ([]byte, error) {
+	return c.AppendTField(append(b, c.PField()), t)
+}
+
+// DecodeTField decodes a T-field of c from the start of b.  The instant is
+// exact to within the resolution of the fraction, truncated toward the past.
+func (c CUC) DecodeTField(b []byte) (TAI, error) {
+	c.check()
+	if len(b) < c.Len() {
+		return TAI{}, fmt.Errorf("CUC.DecodeTField: expected %d octets, got %d", c.Len(), len(b))
+	}
+	var sec int64
+	for _, v := range b[:c.Basic] {
+		sec = sec<<8 | int64(v)
+	}
+	var f int64
+	for _, v := range b[c.Basic:c.Len()] {
+		f = f<<8 | int64(v)
+	}
+	asec := new(big.Int).Mul(big.NewInt(f), bigAsec)
+	asec.Rsh(asec, uint(8*c.Fractional))
+	return c.Epoch.Add(sec, asec.Int64()), nil
+}
+
+// DecodeCUC decodes a time code with a preamble from the start of b, and
+// returns it with the number of octets consumed.  epoch is the agency-defined
+// epoch of a level 2 time code, and is ignored for level 1.  Extended
+// P-fields are not supported.
+func DecodeCUC(b []byte, epoch TAI) (TAI, int, error) {
+	if len(b) == 0 {
+		return TAI{}, 0, errors.New("DecodeCUC: empty time code")
+	}
+	p := b[0]
+	if p&0x80 != 0 {
+		return TAI{}, 0, errors.New("DecodeCUC: extended P-fields are not supported")
+	}
+	c := CUC{Basic: int(p>>2&3) + 1, Fractional: int(p & 3)}
+	switch p >> 4 & 7 {
+	case cucLevel1:
+	case cucLevel2:
+		c.Epoch = epoch
+	default:
+		return TAI{}, 0, fmt.Errorf("DecodeCUC: %03b is not a CUC time code identification", p>>4&7)
+	}
+	t, err := c.DecodeTField(b[1:])
+	if err != nil {
+		return TAI{}, 0, fmt.Errorf("DecodeCUC: %w", err)
+	}
+	return t, 1 + c.Len(), nil
+}
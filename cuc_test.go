@@ -0,0 +1,73 @@
+package tai_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCUC(t *testing.T) {
+	ta := tai.Tai(0x12345678, 5e17+25e16) // .75 s
+	cases := []struct {
+		descr string
+		c     tai.CUC
+		exp   []byte
+		back  tai.TAI
+	}{
+		{"4.2", tai.CUC{Basic: 4, Fractional: 2}, []byte{0x1e, 0x12, 0x34, 0x56, 0x78, 0xc0, 0x00}, ta},
+		{"4.0", tai.CUC{Basic: 4}, []byte{0x1c, 0x12, 0x34, 0x56, 0x78}, tai.Tai(0x12345678, 0)},
+		{"Level2", tai.CUC{Basic: 3, Fractional: 1, Epoch: tai.Tai(0x12000000, 0)}, []byte{0x29, 0x34, 0x56, 0x78, 0xc0}, ta},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			b, err := tc.c.Append(nil, ta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, tc.exp) {
+				t.Fatalf("expected % x, got % x", tc.exp, b)
+			}
+			got, n, err := tai.DecodeCUC(append(b, 0xff), tc.c.Epoch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != len(b) || !got.Eq(tc.back) {
+				t.Fatalf("expected %+v in %d octets, got %+v in %d", tc.back, len(b), got, n)
+			}
+		})
+	}
+}
+
+func TestCUCFraction(t *testing.T) {
+	c := tai.CUC{Basic: 1, Fractional: 3}
+	// one part in 2^24 is about 59.6 ns; 100 ns is truncated to one part
+	b, err := c.AppendTField(nil, tai.Tai(1, 100*tai.Nanosecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, []byte{1, 0, 0, 1}) {
+		t.Fatalf("expected 01 00 00 01, got % x", b)
+	}
+	got, err := c.DecodeTField(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.Tai(1, 59604644775); !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+	if _, err := c.AppendTField(nil, tai.Tai(256, 0)); err != tai.ErrCUCRange {
+		t.Fatalf("expected ErrCUCRange after the greatest count, got %v", err)
+	}
+	if _, err := c.AppendTField(nil, tai.Tai(-1, 0)); err != tai.ErrCUCRange {
+		t.Fatalf("expected ErrCUCRange before the epoch, got %v", err)
+	}
+	if _, err := c.DecodeTField([]byte{1, 0}); err == nil {
+		t.Fatal("expected an error for a short T-field")
+	}
+	for _, b := range [][]byte{nil, {0x9e}, {0x4e, 0, 0, 0, 0, 0, 0}} {
+		if _, _, err := tai.DecodeCUC(b, tai.TAI{}); err == nil {
+			t.Errorf("expected an error decoding % x", b)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package tai
+
+import "sync/atomic"
+
+// DayFormatter formats instants with a fixed layout, caching the rendering of
+// the date portion of the layout, such as "2024-07-01T" of RFC3339, for the
+// most recently seen TAI day.  Instants on the same day as the previous call
+// only render their time of day, as a log encoder formatting a stream of
+// nearby instants does.
+//
+// the date portion is the layout up to its first specifier of the time of day
+// (%H, %I, %p, %M, %S, %f, %F, or %N); a layout that begins with the time of
+// day, or has no date, is rendered in full for every instant.
+//
+// DayFormatter is safe for concurrent use; readers never block.
+type DayFormatter struct {
+	layout string
+	// split is the index of layout at which the time of day portion begins
+	split int
+	v     atomic.Value // *formattedDay
+}
+
+type formattedDay struct {
+	*cachedDay
+	// loc is the locale the prefix was rendered with
+	loc    *Locale
+	prefix []byte
+}
+
+// NewDayFormatter returns a DayFormatter for layout, which takes the
+// specifiers of func Format.  The locale of the Config is used, as by Format.
+func NewDayFormatter(layout string) *DayFormatter {
+	return &DayFormatter{layout: layout, split: dateSplit(layout)}
+}
+
+// dateSplit returns the index of the first specifier of the time of day in
+// layout, or len(layout) if there is none
+func dateSplit(layout string) int {
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			continue
+		}
+		if i+1 == len(layout) {
+			break
+		}
+		switch c := layout[i+1]; {
+		case c == 'H' || c == 'I' || c == 'p' || c == 'M' || c == 'S' || c == 'f' || c == 'F' || c == 'N' || c == '-' || (c >= '0' && c <= '9'):
+			return i
+		case c == 'E':
+			i += 2
+		default:
+			i++
+		}
+	}
+	return len(layout)
+}
+
+// Layout returns the layout of f
+func (f *DayFormatter) Layout() string {
+	return f.layout
+}
+
+// Format is equivalent to t.Format(f.Layout())
+func (f *DayFormatter) Format(t TAI) string {
+	return string(f.AppendFormat(make([]byte, 0, len(f.layout)+24), t))
+}
+
+// AppendFormat is equivalent to t.AppendFormat(b, f.Layout())
+func (f *DayFormatter) AppendFormat(b []byte, t TAI) []byte {
+	fd, _ := f.v.Load().(*formattedDay)
+	b, next := f.appendFormat(b, t, fd, currentConfig().locale())
+	if next != fd {
+		f.v.Store(next)
+	}
+	return b
+}
+
+// appendFormat appends t formatted with the cached day fd, which may be nil,
+// and returns the day that was used
+func (f *DayFormatter) appendFormat(b []byte, t TAI, fd *formattedDay, loc *Locale) ([]byte, *formattedDay) {
+	if fd == nil || !fd.contains(t) || fd.loc != loc {
+		cd := newCachedDay(t)
+		fd = &formattedDay{cachedDay: cd, loc: loc, prefix: appendFormatLocale(nil, cd.date, f.layout[:f.split], loc)}
+	}
+	b = append(b, fd.prefix...)
+	return appendFormatLocale(b, fd.gregorian(t), f.layout[f.split:], loc), fd
+}
+
+// FormatColumn formats each of ts with the layout of f, for writing a column
+// of a Parquet file, CSV, or other columnar output.  The i-th value is
+// appended to dst[i][:0], reusing its storage, and dst is extended if it is
+// shorter than ts; the result has the length of ts.
+//
+// the day's rendered date is shared across the batch without synchronization,
+// so formatting a column costs little more than formatting its times of day.
+func (f *DayFormatter) FormatColumn(dst [][]byte, ts []TAI) [][]byte {
+	if cap(dst) < len(ts) {
+		grown := make([][]byte, len(ts))
+		copy(grown, dst)
+		dst = grown
+	}
+	dst = dst[:len(ts)]
+	loc := currentConfig().locale()
+	fd, _ := f.v.Load().(*formattedDay)
+	first := fd
+	for i, t := range ts {
+		dst[i], fd = f.appendFormat(dst[i][:0], t, fd, loc)
+	}
+	if fd != first {
+		f.v.Store(fd)
+	}
+	return dst
+}
+
+// FormatColumn is func (*DayFormatter) FormatColumn with a DayFormatter for
+// layout
+func FormatColumn(dst [][]byte, ts []TAI, layout string) [][]byte {
+	return NewDayFormatter(layout).FormatColumn(dst, ts)
+}
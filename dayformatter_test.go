@@ -0,0 +1,94 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestDayFormatterMatchesFormat(t *testing.T) {
+	layouts := []string{
+		tai.RFC3339Nano,
+		tai.RFC3339Trimmed,
+		tai.FIX,
+		tai.Syslog,
+		"%A %o %B %EY, %I:%M %p",
+		"%H:%M:%S %Y-%m-%d",
+		"%Y%%%j",
+		"%Y.%-9N",
+	}
+	base := tai.Date(2024, 7, 1)
+	ts := []tai.TAI{
+		base.Add(0, 5e17),
+		base.Add(13*tai.Hour+7, 0),
+		base.Add(-1, 1e18-1),
+		base.Add(tai.Day, 0),
+		tai.Date(-43, 3, 15).Add(9*tai.Hour, 0),
+	}
+	for _, layout := range layouts {
+		f := tai.NewDayFormatter(layout)
+		for _, ta := range ts {
+			if got, exp := f.Format(ta), ta.Format(layout); got != exp {
+				t.Errorf("%q: expected %q, got %q", layout, exp, got)
+			}
+		}
+	}
+}
+
+func TestDayFormatterFollowsLocale(t *testing.T) {
+	defer restoreConfig(t)
+	f := tai.NewDayFormatter("%d %B %H:%M")
+	ta := tai.Date(2024, 7, 1).Add(12*tai.Hour, 0)
+	f.Format(ta)
+	fr := tai.English
+	fr.Months[tai.July-1] = "juillet"
+	if err := tai.Configure(tai.WithLocale(fr)); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := f.Format(ta.Add(60, 0)), "01 juillet 12:01"; got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+}
+
+func BenchmarkDayFormatter(b *testing.B) {
+	f := tai.NewDayFormatter(tai.RFC3339Micro)
+	now := tai.Now()
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = f.AppendFormat(buf[:0], now.Add(0, int64(i)*tai.Microsecond))
+	}
+}
+
+func TestFormatColumn(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	ts := []tai.TAI{base.Add(-1, 0), base, base.Add(1, 5e17), base.Add(2*tai.Day, 0)}
+	dst := make([][]byte, 1, 2)
+	dst[0] = make([]byte, 0, 64)
+	reused := &dst[0][:1][0]
+	dst = tai.FormatColumn(dst, ts, tai.RFC3339Milli)
+	if len(dst) != len(ts) {
+		t.Fatalf("expected %d values, got %d", len(ts), len(dst))
+	}
+	for i, ta := range ts {
+		if got, exp := string(dst[i]), ta.Format(tai.RFC3339Milli); got != exp {
+			t.Errorf("value %d: expected %q, got %q", i, exp, got)
+		}
+	}
+	if &dst[0][0] != reused {
+		t.Error("expected the storage of dst[0] to be reused")
+	}
+}
+
+func BenchmarkFormatColumn(b *testing.B) {
+	f := tai.NewDayFormatter(tai.RFC3339Micro)
+	ts := make([]tai.TAI, 1024)
+	now := tai.Now()
+	for i := range ts {
+		ts[i] = now.Add(0, int64(i)*tai.Millisecond)
+	}
+	dst := f.FormatColumn(nil, ts)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = f.FormatColumn(dst, ts)
+	}
+}
@@ -0,0 +1,113 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DecimalLen is the length of the canonical decimal form of times within
+// about 317 years of the TAI epoch; see func AppendDecimal
+const DecimalLen = 29
+
+// Decimal returns the canonical decimal form of t; see func AppendDecimal
+func (t TAI) Decimal() string {
+	return string(t.AppendDecimal(make([]byte, 0, DecimalLen+1)))
+}
+
+// AppendDecimal appends the canonical decimal form of t to b and returns the
+// extended slice.  The form is the signed number of seconds since the TAI
+// epoch with exactly 18 fractional digits and at least 10 integer digits,
+// e.g. 2099347200.500000000000000000 or -0000000001.250000000000000000.
+//
+// The canonical form is exact and sorts lexically in time order among
+// non-negative times of the same width.  It is the lossless text form of TAI
+// used by the encodings of this package.
+func (t TAI) AppendDecimal(b []byte) []byte {
+	sec, asec := t.sec, t.asec
+	var whole uint64
+	if sec < 0 {
+		b = append(b, '-')
+		if asec > 0 {
+			// -1.25 is stored as sec -2, asec 0.75
+			whole, asec = uint64(-(sec + 1)), 1e18-asec
+		} else {
+			whole = uint64(-sec)
+		}
+	} else {
+		whole = uint64(sec)
+	}
+	var buf [20]byte
+	i := len(buf)
+	for whole >= 10 || len(buf)-i < 9 {
+		i--
+		buf[i] = byte('0' + whole%10)
+		whole /= 10
+	}
+	i--
+	buf[i] = byte('0' + whole)
+	b = append(b, buf[i:]...)
+	b = append(b, '.')
+	return appendInt(b, asec, 18)
+}
+
+// maxDecimalWhole is the magnitude of the most negative number of seconds
+const maxDecimalWhole = 1 << 63
+
+// ParseDecimal parses the decimal form of a time, as produced by Decimal.
+// Any number of integer digits is accepted, but the fractional part must have
+// exactly 18 digits.
+func ParseDecimal(s string) (TAI, error) {
+	p := parser{s: s}
+	neg := p.peek() == '-'
+	if neg {
+		p.next()
+	}
+	start := p.i
+	var whole uint64
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		if whole > maxDecimalWhole/10 {
+			return TAI{}, errors.New("ParseDecimal: seconds out of range")
+		}
+		whole = whole*10 + uint64(c-'0')
+		p.next()
+	}
+	if p.i == start {
+		p.fail("expected digit")
+	}
+	p.expect('.')
+	asec := int64(0)
+	for k := 0; k < 18; k++ {
+		asec = asec*10 + int64(p.digits(1))
+	}
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return TAI{}, fmt.Errorf("ParseDecimal: %w", p.err)
+	}
+	if whole > math.MaxInt64 && !(neg && whole == maxDecimalWhole && asec == 0) {
+		return TAI{}, errors.New("ParseDecimal: seconds out of range")
+	}
+	if neg {
+		return Tai(-int64(whole), -asec), nil
+	}
+	return TAI{sec: int64(whole), asec: asec}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the canonical decimal
+// form; see func AppendDecimal
+func (t TAI) MarshalText() ([]byte, error) {
+	return t.AppendDecimal(make([]byte, 0, DecimalLen+1)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the decimal
+// form; see func ParseDecimal
+func (t *TAI) UnmarshalText(b []byte) error {
+	v, err := ParseDecimal(string(b))
+	if err != nil {
+		return fmt.Errorf("TAI.UnmarshalText: %w", err)
+	}
+	*t = v
+	return nil
+}
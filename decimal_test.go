@@ -0,0 +1,101 @@
+package tai_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestDecimal(t *testing.T) {
+	cases := []struct {
+		descr string
+		in    tai.TAI
+		exp   string
+	}{
+		{"Epoch", tai.TAI{}, "0000000000.000000000000000000"},
+		{"Half", tai.Tai(2099347200, 5e17), "2099347200.500000000000000000"},
+		{"Attosecond", tai.Tai(0, 1), "0000000000.000000000000000001"},
+		{"Negative", tai.Tai(-1, -25e16), "-0000000001.250000000000000000"},
+		{"NegativeFraction", tai.Tai(0, -1), "-0000000000.000000000000000001"},
+		{"Wide", tai.Tai(12345678901, 0), "12345678901.000000000000000000"},
+		{"Max", tai.Tai(math.MaxInt64, 1e18-1), "9223372036854775807.999999999999999999"},
+		{"Min", tai.Tai(math.MinInt64, 0), "-9223372036854775808.000000000000000000"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			s := tc.in.Decimal()
+			if s != tc.exp {
+				t.Fatalf("expected %s, got %s", tc.exp, s)
+			}
+			got, err := tai.ParseDecimal(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(tc.in) {
+				t.Fatalf("expected %+v, got %+v", tc.in, got)
+			}
+		})
+	}
+	if n := len(tai.Now().Decimal()); n != tai.DecimalLen {
+		t.Fatalf("expected DecimalLen %d, got %d", tai.DecimalLen, n)
+	}
+}
+
+func TestParseDecimalInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"1",
+		"1.5",
+		".000000000000000000",
+		"1.0000000000000000000",
+		"+1.000000000000000000",
+		"1.00000000000000000x",
+		"9223372036854775808.000000000000000000",
+		"-9223372036854775808.000000000000000001",
+		"99999999999999999999.000000000000000000",
+	} {
+		if _, err := tai.ParseDecimal(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
+
+func TestTextMarshaling(t *testing.T) {
+	ta := tai.Date(2024, 7, 1).Add(0, 5e17+1)
+	m := map[tai.TAI]int{ta: 1}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := `{"2098483200.500000000000000001":1}`; string(b) != exp {
+		t.Fatalf("expected %s, got %s", exp, b)
+	}
+	var back map[tai.TAI]int
+	if err := json.Unmarshal(b, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back[ta] != 1 {
+		t.Errorf("expected the key to round trip, got %v", back)
+	}
+
+	type event struct {
+		At tai.TAI `xml:"at,attr"`
+	}
+	x, err := xml.Marshal(event{At: ta})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e event
+	if err := xml.Unmarshal(x, &e); err != nil {
+		t.Fatal(err)
+	}
+	if !e.At.Eq(ta) {
+		t.Errorf("expected %+v, got %+v from %s", ta, e.At, x)
+	}
+	if err := e.At.UnmarshalText([]byte("2024-07-01")); err == nil {
+		t.Error("expected an error for text not in decimal form")
+	}
+}
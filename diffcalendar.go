@@ -0,0 +1,52 @@
+package tai
+
+// CalendarDiff is the difference between two instants in calendar units, for
+// human facing displays such as "3 years, 2 months, and 5 days".  All fields
+// have the same sign.
+type CalendarDiff struct {
+	Years, Months, Days     int
+	Hours, Minutes, Seconds int
+	Asec                    int64
+}
+
+// DiffCalendar returns the difference b-a in calendar units of the TAI
+// calendar.  Whole months are counted first, then whole days, then the time
+// of day; a month after January 31 is the last day of February, as with
+// Java's Period and most "age" calculations.  If b is before a, every
+// component is negative or zero.
+func DiffCalendar(a, b TAI) CalendarDiff {
+	if b.Before(a) {
+		d := DiffCalendar(b, a)
+		return CalendarDiff{-d.Years, -d.Months, -d.Days, -d.Hours, -d.Minutes, -d.Seconds, -d.Asec}
+	}
+	da, toda := floorDiv(a.sec, Day)
+	db, todb := floorDiv(b.sec, Day)
+	tod := Dur(todb-toda, b.asec-a.asec)
+	if tod.IsNegative() {
+		db--
+		tod = tod.Add(Dur(Day, 0))
+	}
+	ya, ma, dda := CivilFromDays64(da)
+	yb, mb, ddb := CivilFromDays64(db)
+	months := (yb*12 + mb) - (ya*12 + ma)
+	days := ddb - dda
+	if months > 0 && days < 0 {
+		months--
+		y, m := ya+(ma-1+months)/12, (ma-1+months)%12+1
+		d := dda
+		if dim := DaysInMonth(m, y); d > dim {
+			d = dim
+		}
+		days = int(db - DaysFromCivil64(y, m, d))
+	}
+	sec, asec := tod.Parts()
+	return CalendarDiff{
+		Years:   months / 12,
+		Months:  months % 12,
+		Days:    days,
+		Hours:   int(sec / Hour),
+		Minutes: int(sec % Hour / Minute),
+		Seconds: int(sec % Minute),
+		Asec:    asec,
+	}
+}
@@ -0,0 +1,32 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestDiffCalendar(t *testing.T) {
+	cases := []struct {
+		descr string
+		a, b  tai.TAI
+		exp   tai.CalendarDiff
+	}{
+		{"Zero", tai.Date(2024, 1, 1), tai.Date(2024, 1, 1), tai.CalendarDiff{}},
+		{"Age", tai.Date(1990, 5, 17), tai.Date(2024, 7, 1), tai.CalendarDiff{Years: 34, Months: 1, Days: 14}},
+		{"BorrowDays", tai.Date(2024, 1, 20), tai.Date(2024, 3, 5), tai.CalendarDiff{Months: 1, Days: 14}},
+		{"EndOfMonthClamps", tai.Date(2024, 1, 31), tai.Date(2024, 3, 1), tai.CalendarDiff{Months: 1, Days: 1}},
+		{"BorrowTime", tai.Date(2024, 1, 1).AddHMS(23, 0, 0), tai.Date(2024, 1, 3).AddHMS(1, 30, 0), tai.CalendarDiff{Days: 1, Hours: 2, Minutes: 30}},
+		{"BorrowAsec", tai.Date(2024, 1, 1).Add(0, 75e16), tai.Date(2024, 1, 1).Add(2, 25e16), tai.CalendarDiff{Seconds: 1, Asec: 5e17}},
+		{"LeapDay", tai.Date(2020, 2, 29), tai.Date(2021, 2, 28), tai.CalendarDiff{Months: 11, Days: 30}},
+		{"Negative", tai.Date(2024, 7, 1), tai.Date(1990, 5, 17), tai.CalendarDiff{Years: -34, Months: -1, Days: -14}},
+		{"BeforeEpoch", tai.Date(1900, 3, 1), tai.Date(1958, 1, 1).AddHMS(0, 0, 1), tai.CalendarDiff{Years: 57, Months: 10, Seconds: 1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tai.DiffCalendar(tc.a, tc.b); got != tc.exp {
+				t.Fatalf("expected %+v, got %+v", tc.exp, got)
+			}
+		})
+	}
+}
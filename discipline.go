@@ -0,0 +1,259 @@
+package tai
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// DisciplineMode is the filter a Discipline uses to steer its transform
+type DisciplineMode int
+
+const (
+	// PI steers with a proportional-integral loop, as in a software phase
+	// locked loop
+	PI DisciplineMode = iota
+	// Kalman steers with a two-state (phase, frequency) Kalman filter, which
+	// weighs each observation by the modeled noise of the clock and reference
+	Kalman
+)
+
+var disciplineModeNames = [...]string{"PI", "Kalman"}
+
+// String returns the name of m, e.g. Kalman
+func (m DisciplineMode) String() string {
+	if m < PI || m > Kalman {
+		return fmt.Sprintf("DisciplineMode(%d)", int(m))
+	}
+	return disciplineModeNames[m]
+}
+
+const (
+	defaultKp = 0.5
+	defaultKi = 0.1
+
+	// defaults of the Kalman noise model: a clock that wanders about a
+	// nanosecond per second, observed with microsecond error
+	defaultPhaseNoise       = 1e-18
+	defaultFrequencyNoise   = 1e-24
+	defaultMeasurementNoise = 1e-12
+	// initialFrequencyVariance is the prior of the frequency offset, (10 ppm)^2
+	initialFrequencyVariance = 1e-10
+
+	defaultHoldoverAfter = 64 * Second
+)
+
+// Discipline steers a transform from a local clock, such as a SourceClock
+// reading MonotonicRaw, to TAI using observations of a reference, such as the
+// offsets measured by NTP, PTP, or a GPS receiver.
+//
+// the transform is TAI = base + (local - localBase) * (1 + Frequency), where
+// the base is rebased at every observation; phase arithmetic is exact and
+// only the frequency correction is subject to floating point rounding.
+//
+// When observations stop, the transform continues at the last frequency
+// estimate; this is holdover.  The uncertainty of the transform is tracked
+// with the noise model of the local clock in both modes, and grows with the
+// time since the last observation.
+//
+// The zero value of Discipline is a PI loop with default gains that has not
+// yet observed the reference.  Discipline is safe for concurrent use; its
+// configuration must not be changed after the first call to Update.
+type Discipline struct {
+	// Mode selects the filter
+	Mode DisciplineMode
+
+	// Kp and Ki are the proportional and integral gains of the PI loop, the
+	// fraction of each measured offset corrected in phase and folded into the
+	// frequency estimate.  Zero selects 0.5 and 0.1, respectively.
+	Kp, Ki float64
+
+	// PhaseNoise and FrequencyNoise are the diffusion coefficients of the
+	// local clock's white and random walk frequency noise, in s^2/s and 1/s.
+	// MeasurementNoise is the variance of an observation of the reference in
+	// s^2.  They set the gains of the Kalman filter and the growth of the
+	// uncertainty in holdover.  Zero selects a default.
+	PhaseNoise, FrequencyNoise, MeasurementNoise float64
+
+	// StepThreshold is the measured offset beyond which the transform is
+	// stepped to the reference instead of steered toward it.  Zero disables
+	// stepping, except to set the transform at the first observation.
+	StepThreshold Duration
+
+	// HoldoverAfter is the time since the last observation, by the local
+	// clock, after which the Discipline is in holdover.  Zero selects 64 s.
+	HoldoverAfter Duration
+
+	// Log, if not nil, records every step and steer of the transform
+	Log *AdjustmentLog
+
+	mu        sync.Mutex
+	started   bool
+	localBase TAI
+	base      TAI
+	freq      float64
+	// p is the covariance of the phase and frequency of the transform at
+	// localBase
+	p [2][2]float64
+}
+
+// Update submits an observation of the reference: local is the reading of the
+// local clock at the moment the reference read ref.  It returns the offset of
+// the reference from the transform before the observation was applied.
+//
+// observations must be submitted in increasing order of local.
+func (d *Discipline) Update(local, ref TAI) Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.started {
+		d.started = true
+		d.localBase, d.base = local, ref
+		d.p = [2][2]float64{{d.measurementNoise(), 0}, {0, initialFrequencyVariance}}
+		return Duration{}
+	}
+	predicted := d.transform(local)
+	offset := sub(ref, predicted)
+	dt := sub(local, d.localBase).Seconds()
+	d.localBase = local
+	p := d.predict(dt)
+	if d.StepThreshold != (Duration{}) && d.StepThreshold.Less(offset.abs()) {
+		d.base = ref
+		d.p = [2][2]float64{{d.measurementNoise(), 0}, {0, p[1][1]}}
+		d.Log.record(Adjustment{Kind: Step, At: ref, Phase: offset})
+		return offset
+	}
+	k0, k1 := d.gains(p, dt)
+	steer := Adjustment{Kind: Steer, At: ref, Phase: durationFromSeconds(offset.Seconds() * k0), Frequency: offset.Seconds() * k1}
+	d.base = predicted.AddDuration(steer.Phase)
+	d.freq += steer.Frequency
+	d.Log.record(steer)
+	// Joseph form, P = (I-KH) P (I-KH)' + K R K', which holds for the PI
+	// loop's gains as well as the optimal gains of the Kalman filter
+	r := d.measurementNoise()
+	a00, a10 := 1-k0, -k1
+	d.p = [2][2]float64{
+		{a00*a00*p[0][0] + k0*k0*r, a00*(a10*p[0][0]+p[0][1]) + k0*k1*r},
+		{a00*(a10*p[0][0]+p[1][0]) + k0*k1*r, a10*(a10*p[0][0]+p[0][1]) + a10*p[1][0] + p[1][1] + k1*k1*r},
+	}
+	return offset
+}
+
+// predict returns the covariance of the transform dt seconds after localBase:
+// P = F P F' + Q, with F = [[1, dt], [0, 1]]
+func (d *Discipline) predict(dt float64) [2][2]float64 {
+	q1, q2 := d.PhaseNoise, d.FrequencyNoise
+	if q1 == 0 {
+		q1 = defaultPhaseNoise
+	}
+	if q2 == 0 {
+		q2 = defaultFrequencyNoise
+	}
+	p := d.p
+	return [2][2]float64{
+		{p[0][0] + dt*(p[1][0]+p[0][1]) + dt*dt*p[1][1] + q1*dt + q2*dt*dt*dt/3, p[0][1] + dt*p[1][1] + q2*dt*dt/2},
+		{p[1][0] + dt*p[1][1] + q2*dt*dt/2, p[1][1] + q2*dt},
+	}
+}
+
+// gains returns the fraction of the measured offset to correct in phase, and
+// the factor relating it to the frequency correction, for an observation dt
+// seconds after the last with predicted covariance p
+func (d *Discipline) gains(p [2][2]float64, dt float64) (kPhase, kFreq float64) {
+	if d.Mode != Kalman {
+		kp, ki := d.Kp, d.Ki
+		if kp == 0 {
+			kp = defaultKp
+		}
+		if ki == 0 {
+			ki = defaultKi
+		}
+		if dt <= 0 {
+			return kp, 0
+		}
+		return kp, ki / dt
+	}
+	s := p[0][0] + d.measurementNoise()
+	return p[0][0] / s, p[1][0] / s
+}
+
+func (d *Discipline) measurementNoise() float64 {
+	if d.MeasurementNoise == 0 {
+		return defaultMeasurementNoise
+	}
+	return d.MeasurementNoise
+}
+
+// Transform returns the TAI moment corresponding to a reading of the local
+// clock.  Before the first observation, local is returned unchanged.
+func (d *Discipline) Transform(local TAI) TAI {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.transform(local)
+}
+
+func (d *Discipline) transform(local TAI) TAI {
+	if !d.started {
+		return local
+	}
+	elapsed := sub(local, d.localBase)
+	return d.base.AddDuration(elapsed).AddDuration(durationFromSeconds(elapsed.Seconds() * d.freq))
+}
+
+// Uncertainty returns the standard uncertainty of Transform(local), which
+// grows with the time since the last observation.  It returns false if there
+// have been no observations.
+func (d *Discipline) Uncertainty(local TAI) (Duration, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.started {
+		return Duration{}, false
+	}
+	p := d.predict(sub(local, d.localBase).abs().Seconds())
+	return durationFromSeconds(math.Sqrt(p[0][0])), true
+}
+
+// Holdover returns true if the last observation is more than HoldoverAfter
+// before local, or there have been no observations
+func (d *Discipline) Holdover(local TAI) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.started {
+		return true
+	}
+	after := d.HoldoverAfter
+	if after == (Duration{}) {
+		after = Dur(defaultHoldoverAfter, 0)
+	}
+	return after.Less(sub(local, d.localBase))
+}
+
+// LastUpdate returns the local clock reading of the last observation, and
+// false if there is none
+func (d *Discipline) LastUpdate() (TAI, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.localBase, d.started
+}
+
+// Frequency returns the estimated fractional frequency offset of the local
+// clock: a Frequency of 1e-6 means the local clock counts one microsecond per
+// second too few
+func (d *Discipline) Frequency() float64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.freq
+}
+
+// Clock returns a Clock whose Now is the transform of local.Now
+func (d *Discipline) Clock(local Clock) Clock {
+	return disciplinedClock{d: d, local: local}
+}
+
+type disciplinedClock struct {
+	d     *Discipline
+	local Clock
+}
+
+func (c disciplinedClock) Now() TAI {
+	return c.d.Transform(c.local.Now())
+}
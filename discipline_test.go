@@ -0,0 +1,97 @@
+package tai_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+// simulateDiscipline feeds d one observation per second of a local clock
+// that runs 20 ppm slow and starts 3 ms behind, returning the local clock
+// reading at the last observation and the reference at that moment
+func simulateDiscipline(d *tai.Discipline, n int) (local, ref tai.TAI) {
+	origin := tai.Date(2024, 7, 1)
+	const slow = 20e-6
+	for i := 0; i < n; i++ {
+		ref = origin.Add(int64(i), 0)
+		lag := tai.Dur(0, 3*tai.Millisecond).Add(tai.Dur(0, int64(float64(i)*slow*1e18)))
+		local = ref.AddDuration(lag.Neg())
+		d.Update(local, ref)
+	}
+	return local, ref
+}
+
+func TestDisciplineConverges(t *testing.T) {
+	for _, mode := range []tai.DisciplineMode{tai.PI, tai.Kalman} {
+		t.Run(mode.String(), func(t *testing.T) {
+			d := &tai.Discipline{Mode: mode}
+			local, ref := simulateDiscipline(d, 300)
+			if f := d.Frequency(); math.Abs(f-20e-6) > 1e-8 {
+				t.Errorf("expected frequency near 20e-6, got %v", f)
+			}
+			// one second later by the reference is 20 µs less by the local clock
+			next := local.Add(1, -20*tai.Microsecond)
+			err := d.Transform(next).Sub(ref.Add(1, 0)).Seconds()
+			if math.Abs(err) > 1e-8 {
+				t.Errorf("expected prediction within 10 ns, off by %v s", err)
+			}
+		})
+	}
+}
+
+func TestDisciplineStep(t *testing.T) {
+	d := &tai.Discipline{StepThreshold: tai.Dur(0, 128*tai.Millisecond)}
+	origin := tai.Date(2024, 7, 1)
+	d.Update(origin, origin)
+	if off := d.Update(origin.Add(1, 0), origin.Add(3, 0)); !off.Eq(tai.Dur(2, 0)) {
+		t.Fatalf("expected offset of 2 s, got %+v", off)
+	}
+	if got, exp := d.Transform(origin.Add(1, 0)), origin.Add(3, 0); !got.Eq(exp) {
+		t.Errorf("expected the transform to step to %+v, got %+v", exp, got)
+	}
+	if f := d.Frequency(); f != 0 {
+		t.Errorf("expected a step to leave the frequency unchanged, got %v", f)
+	}
+}
+
+func TestDisciplineBeforeFirstUpdate(t *testing.T) {
+	var d tai.Discipline
+	t0 := tai.Date(2024, 7, 1)
+	if got := d.Transform(t0); !got.Eq(t0) {
+		t.Errorf("expected identity transform, got %+v", got)
+	}
+}
+
+func TestDisciplineHoldover(t *testing.T) {
+	for _, mode := range []tai.DisciplineMode{tai.PI, tai.Kalman} {
+		t.Run(mode.String(), func(t *testing.T) {
+			d := &tai.Discipline{Mode: mode, HoldoverAfter: tai.Dur(10, 0)}
+			if _, ok := d.Uncertainty(tai.Date(2024, 7, 1)); ok {
+				t.Fatal("expected no uncertainty before the first observation")
+			}
+			local, _ := simulateDiscipline(d, 300)
+			if last, ok := d.LastUpdate(); !ok || !last.Eq(local) {
+				t.Fatalf("expected last update at %+v, got %+v", local, last)
+			}
+			if d.Holdover(local.Add(5, 0)) {
+				t.Error("expected no holdover 5 s after an observation")
+			}
+			if !d.Holdover(local.Add(60, 0)) {
+				t.Error("expected holdover 60 s after an observation")
+			}
+			f := d.Frequency()
+			prev, _ := d.Uncertainty(local)
+			for _, s := range []int64{60, 3600, 86400} {
+				u, _ := d.Uncertainty(local.Add(s, 0))
+				if !prev.Less(u) {
+					t.Errorf("expected uncertainty to grow in holdover, %+v then %+v at %d s", prev, u, s)
+				}
+				prev = u
+			}
+			if d.Frequency() != f {
+				t.Error("expected the frequency estimate to be held")
+			}
+		})
+	}
+}
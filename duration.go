@@ -0,0 +1,178 @@
+package tai
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"time"
+)
+
+// Duration represents an elapsed span of atomic time with attosecond
+// resolution.  Unlike time.Duration, its range is the same as TAI.
+//
+// The zero value of Duration is a span of zero length.
+type Duration struct {
+	// sec is the number of whole seconds
+	sec int64
+	// asec is the number of attoseconds, 0 <= asec < 1e18, such that negative
+	// durations have a negative sec and a non-negative asec
+	asec int64
+}
+
+// Dur returns a Duration of sec seconds plus asec attoseconds.  asec may be any
+// value, and is normalized the same as by func Tai.
+func Dur(sec, asec int64) Duration {
+	t := Tai(sec, asec)
+	return Duration{sec: t.sec, asec: t.asec}
+}
+
+// Parts returns the seconds and attoseconds of d, with 0 <= asec < 1e18
+func (d Duration) Parts() (sec, asec int64) {
+	return d.sec, d.asec
+}
+
+// Add returns d+o
+func (d Duration) Add(o Duration) Duration {
+	return Dur(d.sec+o.sec, d.asec+o.asec)
+}
+
+// Sub returns d-o
+func (d Duration) Sub(o Duration) Duration {
+	return Dur(d.sec-o.sec, d.asec-o.asec)
+}
+
+// Neg returns -d
+func (d Duration) Neg() Duration {
+	return Dur(-d.sec, -d.asec)
+}
+
+// Mul returns d*n.  Behavior is undefined if the result overflows the range
+// of Duration.
+func (d Duration) Mul(n int64) Duration {
+	un := uint64(n)
+	sign := int64(1)
+	if n < 0 {
+		un = uint64(-n)
+		sign = -1
+	}
+	// asec * |n| may exceed 64 bits; carry whole seconds out of the product
+	hi, lo := bits.Mul64(uint64(d.asec), un)
+	carry, asec := bits.Div64(hi, lo, 1e18)
+	return Dur(d.sec*n+sign*int64(carry), sign*int64(asec))
+}
+
+// Less returns true if d is shorter than o
+func (d Duration) Less(o Duration) bool {
+	return d.sec < o.sec || (d.sec == o.sec && d.asec < o.asec)
+}
+
+// Eq returns true if d and o are the same length
+func (d Duration) Eq(o Duration) bool {
+	return d.sec == o.sec && d.asec == o.asec
+}
+
+// IsNegative returns true if d is less than zero
+func (d Duration) IsNegative() bool {
+	return d.sec < 0
+}
+
+// abs returns the magnitude of d
+func (d Duration) abs() Duration {
+	if d.IsNegative() {
+		return d.Neg()
+	}
+	return d
+}
+
+// Seconds returns d as a floating point number of seconds
+func (d Duration) Seconds() float64 {
+	return float64(d.sec) + float64(d.asec)/1e18
+}
+
+// AsStdDuration returns d as a time.Duration, truncated toward the past to
+// nanosecond resolution.  Durations outside of the range of time.Duration
+// (~292 years) saturate.
+func (d Duration) AsStdDuration() time.Duration {
+	ns := d.asec / Nanosecond
+	if d.sec > (math.MaxInt64-ns)/int64(time.Second) {
+		return math.MaxInt64
+	}
+	if d.sec < math.MinInt64/int64(time.Second) {
+		return math.MinInt64
+	}
+	return time.Duration(d.sec*1e9 + ns)
+}
+
+// FromStdDuration returns the Duration equivalent to d
+func FromStdDuration(d time.Duration) Duration {
+	return Dur(int64(d/time.Second), int64(d%time.Second)*Nanosecond)
+}
+
+// AddDuration returns t offset by d
+func (t TAI) AddDuration(d Duration) TAI {
+	return t.Add(d.sec, d.asec)
+}
+
+// Sub returns the elapsed atomic time t-o, which is negative if t is before o
+func (t TAI) Sub(o TAI) Duration {
+	return sub(t, o)
+}
+
+// sub returns the elapsed time t-o
+func sub(t, o TAI) Duration {
+	return Dur(t.sec-o.sec, t.asec-o.asec)
+}
+
+// divmod returns the floor quotient and remainder of d/o, such that
+// d = q*o + r and r has the sign of o.  It panics if o is zero.  Behavior is
+// undefined if the quotient overflows int64.
+func divmod(d, o Duration) (q int64, r Duration) {
+	if o == (Duration{}) {
+		panic("tai: division by zero Duration")
+	}
+	// fast path: both fit in int64 attoseconds
+	if d.sec > -9 && d.sec < 9 && o.sec > -9 && o.sec < 9 {
+		a := d.sec*1e18 + d.asec
+		b := o.sec*1e18 + o.asec
+		q, rem := a/b, a%b
+		if rem != 0 && (rem < 0) != (b < 0) {
+			q--
+			rem += b
+		}
+		return q, Dur(0, rem)
+	}
+	a, b := d.big(), o.big()
+	bq, br := new(big.Int).QuoRem(a, b, new(big.Int))
+	if br.Sign() != 0 && br.Sign() != b.Sign() {
+		bq.Sub(bq, bigOne)
+		br.Add(br, b)
+	}
+	return bq.Int64(), durationFromBig(br)
+}
+
+var (
+	bigOne  = big.NewInt(1)
+	bigAsec = big.NewInt(1e18)
+)
+
+// big returns d as a number of attoseconds
+func (d Duration) big() *big.Int {
+	b := new(big.Int).Mul(big.NewInt(d.sec), bigAsec)
+	return b.Add(b, big.NewInt(d.asec))
+}
+
+// durationFromBig returns the Duration of b attoseconds
+func durationFromBig(b *big.Int) Duration {
+	sec, asec := new(big.Int).DivMod(b, bigAsec, new(big.Int))
+	return Duration{sec: sec.Int64(), asec: asec.Int64()}
+}
+
+// div returns d/n rounded toward negative infinity.  It panics if n is zero.
+func (d Duration) div(n int64) Duration {
+	q := new(big.Int).Div(d.big(), big.NewInt(n))
+	if n < 0 && new(big.Int).Mul(q, big.NewInt(n)).Cmp(d.big()) != 0 {
+		// big.Int.Div is Euclidean; for negative divisors that rounds up
+		q.Sub(q, bigOne)
+	}
+	return durationFromBig(q)
+}
@@ -0,0 +1,61 @@
+package tai_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestDurNormalizes(t *testing.T) {
+	d := tai.Dur(1, -1)
+	sec, asec := d.Parts()
+	if sec != 0 || asec != 1e18-1 {
+		t.Fatalf("expected (0, 1e18-1), got (%d, %d)", sec, asec)
+	}
+	if !d.Neg().IsNegative() {
+		t.Fatal("negation of a positive duration is not negative")
+	}
+}
+
+func TestDurationArithmetic(t *testing.T) {
+	cases := []struct {
+		descr string
+		got   tai.Duration
+		exp   tai.Duration
+	}{
+		{"Add", tai.Dur(1, 6e17).Add(tai.Dur(0, 6e17)), tai.Dur(2, 2e17)},
+		{"Sub", tai.Dur(1, 0).Sub(tai.Dur(0, 1)), tai.Dur(0, 1e18-1)},
+		{"SubNegative", tai.Dur(0, 0).Sub(tai.Dur(1, 5e17)), tai.Dur(-2, 5e17)},
+		{"MulCarries", tai.Dur(0, 9e17).Mul(1e9), tai.Dur(9e8, 0)},
+		{"MulNegative", tai.Dur(1, 5e17).Mul(-3), tai.Dur(-5, 5e17)},
+		{"MulLarge", tai.Dur(0, 1).Mul(math.MaxInt64), tai.Dur(9, 223372036854775807)},
+		{"TAISub", tai.Tai(5, 1).Sub(tai.Tai(3, 2)), tai.Dur(1, 1e18-1)},
+		{"TAISubNegative", tai.Tai(3, 2).Sub(tai.Tai(5, 1)), tai.Dur(-2, 1)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if !tc.got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, tc.got)
+			}
+		})
+	}
+}
+
+func TestDurationStdRoundTrip(t *testing.T) {
+	for _, d := range []time.Duration{0, time.Nanosecond, -1500 * time.Millisecond, math.MaxInt64, math.MinInt64} {
+		if got := tai.FromStdDuration(d).AsStdDuration(); got != d {
+			t.Errorf("expected %v, got %v", d, got)
+		}
+	}
+}
+
+func TestAsStdDurationSaturates(t *testing.T) {
+	if got := tai.Dur(1e12, 0).AsStdDuration(); got != math.MaxInt64 {
+		t.Fatalf("expected saturation, got %v", got)
+	}
+	if got := tai.Dur(-1e12, 0).AsStdDuration(); got != math.MinInt64 {
+		t.Fatalf("expected saturation, got %v", got)
+	}
+}
@@ -0,0 +1,11 @@
+package tai
+
+import "sync/atomic"
+
+// SetLeapExpiry sets the expiry of the leap second table to the UNIX time exp
+// and returns the previous one, so that tests can restore it
+func SetLeapExpiry(exp int64) (old int64) {
+	old = atomic.SwapInt64(&leapExpires, exp)
+	rearmStale()
+	return old
+}
@@ -0,0 +1,83 @@
+package tai
+
+import (
+	"math"
+	"math/big"
+)
+
+// ExpSpace returns n instants from start to end, inclusive, whose successive
+// spacings grow by the factor ratio, such as a sampling plan that is dense
+// after an event and sparse long after it.  A ratio of 1 spaces the instants
+// evenly, and a ratio less than 1 makes them dense toward end.
+//
+// the arithmetic is exact: each instant is the fraction of end - start that
+// its position calls for, given the binary value of ratio, rounded down to the
+// attosecond.  The cost of the exact arithmetic grows with the square of n.
+//
+// ExpSpace returns nil if n is less than one, and start alone if n is one.  It
+// panics if ratio is not positive and finite.
+func ExpSpace(start, end TAI, n int, ratio float64) []TAI {
+	if !(ratio > 0) || math.IsInf(ratio, 0) {
+		panic("tai.ExpSpace: ratio must be positive and finite")
+	}
+	if n < 1 {
+		return nil
+	}
+	out := make([]TAI, n)
+	out[0] = start
+	if n == 1 {
+		return out
+	}
+	total := sub(end, start).big()
+	if ratio == 1 {
+		last := big.NewInt(int64(n - 1))
+		for i := 1; i < n-1; i++ {
+			x := new(big.Int).Mul(total, big.NewInt(int64(i)))
+			out[i] = start.AddDuration(durationFromBig(x.Div(x, last)))
+		}
+		out[n-1] = end
+		return out
+	}
+	// the i-th instant is (ratio^i - 1) / (ratio^(n-1) - 1) of the way from
+	// start to end.  ratio is a/2^k exactly, so the fraction is the ratio of
+	// integers (a^i - 2^ki) 2^k(n-1-i) / (a^(n-1) - 2^k(n-1)).
+	rat := new(big.Rat).SetFloat64(ratio)
+	a := rat.Num()
+	k := uint(rat.Denom().BitLen() - 1)
+	last := uint(n - 1)
+	denom := new(big.Int).Exp(a, big.NewInt(int64(last)), nil)
+	denom.Sub(denom, new(big.Int).Lsh(bigOne, k*last))
+	if denom.Sign() < 0 {
+		denom.Neg(denom)
+		total.Neg(total)
+	}
+	pow := big.NewInt(1)
+	for i := uint(1); i < last; i++ {
+		pow.Mul(pow, a)
+		x := new(big.Int).Sub(pow, new(big.Int).Lsh(bigOne, k*i))
+		x.Lsh(x, k*(last-i)).Mul(x, total)
+		// Div is Euclidean, which for a positive divisor rounds down
+		out[i] = start.AddDuration(durationFromBig(x.Div(x, denom)))
+	}
+	out[n-1] = end
+	return out
+}
+
+// GeomSpace returns n instants from start to end, inclusive, that are evenly
+// spaced in the logarithm of their time since origin, such as the decades of
+// a log-uniform sampling plan.  start and end must both be after origin, or
+// both before it.  See func ExpSpace for the exactness of the result.
+//
+// GeomSpace panics if start or end is origin, or they are on opposite sides of
+// origin.
+func GeomSpace(origin, start, end TAI, n int) []TAI {
+	a, b := sub(start, origin).Seconds(), sub(end, origin).Seconds()
+	if !(a/b > 0) {
+		panic("tai.GeomSpace: start and end must be on the same side of origin")
+	}
+	ratio := 1.0
+	if n > 1 {
+		ratio = math.Pow(b/a, 1/float64(n-1))
+	}
+	return ExpSpace(start, end, n, ratio)
+}
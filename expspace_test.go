@@ -0,0 +1,77 @@
+package tai_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestExpSpace(t *testing.T) {
+	start := tai.Date(2024, 7, 1)
+	end := start.Add(15, 0)
+	got := tai.ExpSpace(start, end, 5, 2)
+	exp := []tai.TAI{start, start.Add(1, 0), start.Add(3, 0), start.Add(7, 0), end}
+	if len(got) != len(exp) {
+		t.Fatalf("expected %d instants, got %d", len(exp), len(got))
+	}
+	for i := range exp {
+		if !got[i].Eq(exp[i]) {
+			t.Errorf("instant %d: expected %+v, got %+v", i, exp[i], got[i])
+		}
+	}
+}
+
+func TestExpSpaceEven(t *testing.T) {
+	start := tai.Date(2024, 7, 1)
+	end := start.Add(0, 3)
+	got := tai.ExpSpace(start, end, 4, 1)
+	for i, g := range got {
+		if exp := start.Add(0, int64(i)); !g.Eq(exp) {
+			t.Errorf("instant %d: expected %+v, got %+v", i, exp, g)
+		}
+	}
+	if got := tai.ExpSpace(start, end, 0, 1); got != nil {
+		t.Errorf("expected nil for n of zero, got %v", got)
+	}
+}
+
+func TestExpSpaceLongSpanEndpointsExact(t *testing.T) {
+	start := tai.Tai(0, 1)
+	end := tai.Date(2200, 1, 1).Add(0, 7)
+	got := tai.ExpSpace(start, end, 50, 1.5)
+	if !got[0].Eq(start) || !got[len(got)-1].Eq(end) {
+		t.Fatalf("expected exact endpoints, got %+v and %+v", got[0], got[len(got)-1])
+	}
+	for i := 1; i < len(got); i++ {
+		if !got[i].After(got[i-1]) {
+			t.Fatalf("instant %d is not after instant %d", i, i-1)
+		}
+	}
+}
+
+func TestExpSpaceInvalidRatio(t *testing.T) {
+	start, end := tai.Date(2024, 7, 1), tai.Date(2024, 7, 2)
+	for _, ratio := range []float64{0, -1, math.NaN(), math.Inf(1), math.Inf(-1)} {
+		t.Run(fmt.Sprint(ratio), func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("expected a panic")
+				}
+			}()
+			tai.ExpSpace(start, end, 5, ratio)
+		})
+	}
+}
+
+func TestGeomSpace(t *testing.T) {
+	origin := tai.Date(2024, 7, 1)
+	got := tai.GeomSpace(origin, origin.Add(0, tai.Millisecond), origin.Add(1000, 0), 7)
+	for i, g := range got {
+		since := g.Sub(origin).Seconds()
+		if exp := math.Pow(10, float64(i-3)); math.Abs(since-exp)/exp > 1e-12 {
+			t.Errorf("instant %d: expected %v s after origin, got %v", i, exp, since)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package tai
+
+import (
+	"fmt"
+	"os"
+)
+
+// FileModTime returns the modification time of fi in TAI.  File systems record
+// UTC, so the leap second table is consulted in making the conversion; see
+// func Unix.
+func FileModTime(fi os.FileInfo) TAI {
+	return FromTime(fi.ModTime())
+}
+
+// FileAccessTime returns the access time of fi in TAI, from the platform's
+// stat structure in fi.Sys().  It returns false on platforms which do not
+// record it there, and for a fi not from os.Stat or os.Lstat.
+func FileAccessTime(fi os.FileInfo) (TAI, bool) {
+	return fileAccessTime(fi)
+}
+
+// FileBirthTime returns the creation time of fi in TAI, from the platform's
+// stat structure in fi.Sys(); it is recorded on macOS, FreeBSD, NetBSD, and
+// Windows.  It returns false on other platforms, such as Linux, where it is
+// available only from statx(2), and for a fi not from os.Stat or os.Lstat.
+func FileBirthTime(fi os.FileInfo) (TAI, bool) {
+	return fileBirthTime(fi)
+}
+
+// ModTime returns the modification time of the named file in TAI; see func
+// FileModTime
+func ModTime(path string) (TAI, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return TAI{}, fmt.Errorf("ModTime: %w", err)
+	}
+	return FileModTime(fi), nil
+}
+
+// Chtimes sets the access and modification times of the named file, as by
+// os.Chtimes.  The file system's resolution may be coarser than a TAI, and an
+// instant within a leap second is recorded as by AsTime.
+func Chtimes(path string, atime, mtime TAI) error {
+	if err := os.Chtimes(path, atime.AsTime(), mtime.AsTime()); err != nil {
+		return fmt.Errorf("Chtimes: %w", err)
+	}
+	return nil
+}
+
+// ModifiedSince returns true if the named file was modified after t, such as
+// to decide whether a cached artifact is newer than a deadline
+func ModifiedSince(path string, t TAI) (bool, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("ModifiedSince: %w", err)
+	}
+	return FileModTime(fi).After(t), nil
+}
@@ -0,0 +1,25 @@
+//go:build darwin || freebsd || netbsd
+// +build darwin freebsd netbsd
+
+package tai
+
+import (
+	"os"
+	"syscall"
+)
+
+func fileAccessTime(fi os.FileInfo) (TAI, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return TAI{}, false
+	}
+	return FromTimespec(st.Atimespec), true
+}
+
+func fileBirthTime(fi os.FileInfo) (TAI, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return TAI{}, false
+	}
+	return FromTimespec(st.Birthtimespec), true
+}
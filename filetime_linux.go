@@ -0,0 +1,19 @@
+package tai
+
+import (
+	"os"
+	"syscall"
+)
+
+func fileAccessTime(fi os.FileInfo) (TAI, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return TAI{}, false
+	}
+	return FromTimespec(st.Atim), true
+}
+
+// the birth time is not in struct stat on Linux
+func fileBirthTime(fi os.FileInfo) (TAI, bool) {
+	return TAI{}, false
+}
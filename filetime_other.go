@@ -0,0 +1,14 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !windows
+// +build !linux,!darwin,!freebsd,!netbsd,!windows
+
+package tai
+
+import "os"
+
+func fileAccessTime(fi os.FileInfo) (TAI, bool) {
+	return TAI{}, false
+}
+
+func fileBirthTime(fi os.FileInfo) (TAI, bool) {
+	return TAI{}, false
+}
@@ -0,0 +1,96 @@
+package tai_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestChtimesModTime(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "f")
+	if err := ioutil.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// whole seconds, as file systems may be coarser than nanoseconds
+	mtime := tai.Unix(1719837296, 0)
+	if err := tai.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	got, err := tai.ModTime(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(mtime) {
+		t.Fatalf("expected %+v, got %+v", mtime, got)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tai.FileModTime(fi); !got.Eq(mtime) {
+		t.Fatalf("expected FileModTime to agree, got %+v", got)
+	}
+	cases := []struct {
+		descr    string
+		deadline tai.TAI
+		exp      bool
+	}{
+		{"Before", mtime.Add(-1, 0), true},
+		{"At", mtime, false},
+		{"After", mtime.Add(1, 0), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			ok, err := tai.ModifiedSince(path, tc.deadline)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, ok)
+			}
+		})
+	}
+	if _, err := tai.ModTime(filepath.Join(dir, "missing")); !os.IsNotExist(errors.Unwrap(err)) {
+		t.Fatalf("expected a wrapped not-exist error, got %v", err)
+	}
+}
+
+func TestFileAccessBirthTime(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "f")
+	if err := ioutil.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	atime, mtime := tai.Unix(1719837296, 0), tai.Unix(1719837396, 0)
+	if err := tai.Chtimes(path, atime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := tai.FileAccessTime(fi)
+	switch runtime.GOOS {
+	case "linux", "darwin", "freebsd", "netbsd", "windows":
+		if !ok || !got.Eq(atime) {
+			t.Fatalf("expected the access time %+v, got %+v, %v", atime, got, ok)
+		}
+	}
+	if btime, ok := tai.FileBirthTime(fi); ok && btime.After(tai.Now()) {
+		t.Fatalf("expected a birth time in the past, got %+v", btime)
+	}
+}
@@ -0,0 +1,29 @@
+package tai
+
+import (
+	"os"
+	"syscall"
+)
+
+func fileAccessTime(fi os.FileInfo) (TAI, bool) {
+	d, ok := fi.Sys().(*syscall.Win32FileAttributeData)
+	if !ok {
+		return TAI{}, false
+	}
+	return fromFiletime(d.LastAccessTime), true
+}
+
+func fileBirthTime(fi os.FileInfo) (TAI, bool) {
+	d, ok := fi.Sys().(*syscall.Win32FileAttributeData)
+	if !ok {
+		return TAI{}, false
+	}
+	return fromFiletime(d.CreationTime), true
+}
+
+// fromFiletime converts a FILETIME, a count of 100 ns intervals of UTC since
+// 1601, through the leap second table
+func fromFiletime(ft syscall.Filetime) TAI {
+	ns := ft.Nanoseconds()
+	return Unix(ns/1e9, ns%1e9)
+}
@@ -0,0 +1,142 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// maxGitOffset is the greatest offset from UTC in use, that of UTC+14:00
+const maxGitOffset = 14 * Hour
+
+// ErrImpossibleGitTime is returned by GitTime.Validate for a timestamp that no
+// commit could have been made at
+var ErrImpossibleGitTime = errors.New("tai: impossible git timestamp")
+
+// GitTime is a git author or committer timestamp: an instant and the offset
+// of the committer's time zone
+type GitTime struct {
+	// At is the instant of the commit
+	At TAI
+	// Offset is the offset of the time zone in seconds east of UTC, as by
+	// time.Zone
+	Offset int
+}
+
+// ParseGitTime parses the timestamp of a git author or committer line, UNIX
+// seconds and an offset from UTC such as 1719837296 +0200.  Like git, it
+// accepts any four-digit offset; see func ParseGitTimeStrict to reject
+// impossible timestamps.  The leap second table is consulted in making the
+// conversion; see func Unix.
+func ParseGitTime(s string) (GitTime, error) {
+	g, _, err := parseGitTime(s)
+	if err != nil {
+		return GitTime{}, fmt.Errorf("ParseGitTime: %w", err)
+	}
+	return g, nil
+}
+
+// ParseGitTimeStrict is ParseGitTime, also returning an error wrapping
+// ErrImpossibleGitTime if the offset has 60 or more minutes or g fails
+// Validate
+func ParseGitTimeStrict(s string, now TAI) (GitTime, error) {
+	g, mm, err := parseGitTime(s)
+	if err != nil {
+		return GitTime{}, fmt.Errorf("ParseGitTimeStrict: %w", err)
+	}
+	if mm >= 60 {
+		return GitTime{}, fmt.Errorf("ParseGitTimeStrict: %w: %s has an offset of %d minutes", ErrImpossibleGitTime, s, mm)
+	}
+	if err := g.Validate(now); err != nil {
+		return GitTime{}, fmt.Errorf("ParseGitTimeStrict: %w", err)
+	}
+	return g, nil
+}
+
+// parseGitTime is ParseGitTime, also returning the minutes of the offset as
+// written
+func parseGitTime(s string) (g GitTime, mm int, err error) {
+	p := parser{s: s}
+	neg := p.peek() == '-'
+	if neg {
+		p.next()
+	}
+	start := p.i
+	var secs int64
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		if secs > (math.MaxInt64-9)/10 {
+			return GitTime{}, 0, errors.New("seconds out of range")
+		}
+		secs = secs*10 + int64(c-'0')
+		p.next()
+	}
+	if p.i == start {
+		p.fail("expected digit")
+	}
+	p.expect(' ')
+	sign := p.next()
+	if p.err == nil && sign != '+' && sign != '-' {
+		p.fail("expected + or - before the offset")
+	}
+	hh, mm := p.digits(2), p.digits(2)
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return GitTime{}, 0, p.err
+	}
+	if neg {
+		secs = -secs
+	}
+	off := hh*Hour + mm*Minute
+	if sign == '-' {
+		off = -off
+	}
+	return GitTime{At: Unix(secs, 0), Offset: off}, mm, nil
+}
+
+// Validate returns an error wrapping ErrImpossibleGitTime if g could not be
+// the time of a real commit: it is after now or before the UNIX epoch, or its
+// offset is beyond that of any time zone, 14 hours.  Such timestamps are
+// forged or come from a misconfigured clock, and are of interest to supply
+// chain and forensic tools.
+func (g GitTime) Validate(now TAI) error {
+	abs := g.Offset
+	if abs < 0 {
+		abs = -abs
+	}
+	secs, _ := g.At.unix()
+	switch {
+	case g.At.After(now):
+		return fmt.Errorf("%w: %s is in the future", ErrImpossibleGitTime, g)
+	case secs < 0:
+		return fmt.Errorf("%w: %s is before the UNIX epoch", ErrImpossibleGitTime, g)
+	case abs > maxGitOffset:
+		return fmt.Errorf("%w: %s has an offset beyond any time zone", ErrImpossibleGitTime, g)
+	}
+	return nil
+}
+
+// String returns g in the form of a git author or committer line, e.g.
+// 1719837296 +0200.  The instant is truncated toward the past to seconds.
+func (g GitTime) String() string {
+	secs, _ := g.At.unix()
+	b := strconv.AppendInt(make([]byte, 0, 16), secs, 10)
+	off := g.Offset
+	if off < 0 {
+		b = append(b, " -"...)
+		off = -off
+	} else {
+		b = append(b, " +"...)
+	}
+	b = appendInt(b, int64(off/Hour), 2)
+	b = appendInt(b, int64(off/Minute%60), 2)
+	return string(b)
+}
+
+// Time returns g as a time.Time in a fixed zone of its offset
+func (g GitTime) Time() time.Time {
+	return g.At.AsTime().In(time.FixedZone("", g.Offset))
+}
@@ -0,0 +1,97 @@
+package tai_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestParseGitTime(t *testing.T) {
+	cases := []struct {
+		descr  string
+		s      string
+		unix   int64
+		offset int
+	}{
+		{"East", "1719837296 +0200", 1719837296, 2 * 3600},
+		{"West", "1719837296 -0930", 1719837296, -(9*3600 + 30*60)},
+		{"UTC", "0 +0000", 0, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			g, err := tai.ParseGitTime(tc.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !g.At.Eq(tai.Unix(tc.unix, 0)) || g.Offset != tc.offset {
+				t.Fatalf("expected %d with offset %d, got %+v", tc.unix, tc.offset, g)
+			}
+			if got := g.String(); got != tc.s {
+				t.Fatalf("expected %q, got %q", tc.s, got)
+			}
+			if _, off := g.Time().Zone(); off != tc.offset {
+				t.Fatalf("expected the time in a zone of offset %d, got %d", tc.offset, off)
+			}
+		})
+	}
+	for _, s := range []string{"", "1719837296", "1719837296 0200", "1719837296 +02", "x +0000", "1719837296 +0200 "} {
+		if _, err := tai.ParseGitTime(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestParseGitTimeStrict(t *testing.T) {
+	now := tai.FromTime(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))
+	cases := []struct {
+		descr string
+		s     string
+		ok    bool
+	}{
+		{"Valid", "1719700000 +0200", true},
+		{"Kiribati", "1719700000 +1400", true},
+		{"Future", "1719837296 +0000", false},
+		{"BeforeEpoch", "-1 +0000", false},
+		{"Minutes", "1719700000 +0075", false},
+		{"NoSuchZone", "1719700000 -1500", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			_, err := tai.ParseGitTimeStrict(tc.s, now)
+			if tc.ok != (err == nil) {
+				t.Fatalf("expected ok %v, got %v", tc.ok, err)
+			}
+			if err != nil && !errors.Is(err, tai.ErrImpossibleGitTime) {
+				t.Fatalf("expected ErrImpossibleGitTime, got %v", err)
+			}
+			if _, err := tai.ParseGitTime(tc.s); err != nil {
+				t.Fatalf("expected the lenient parse to accept it, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGitTimeJSON(t *testing.T) {
+	g, err := tai.ParseGitTime("1719837296 -0930")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back tai.GitTime
+	if err := json.Unmarshal(b, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back != g {
+		t.Fatalf("expected %+v to round trip through %s, got %+v", g, b, back)
+	}
+	if s := fmt.Sprintf("%v", g); s != "1719837296 -0930" {
+		t.Fatalf("expected %%v to include the offset, got %s", s)
+	}
+}
@@ -0,0 +1,26 @@
+package tai
+
+const (
+	// GPSOffset is TAI-GPS, which is constant as GPS time has no leap seconds
+	GPSOffset = 19 * Second
+	// GPSWeek is the length of a GPS week
+	GPSWeek = 7 * Day
+)
+
+// GPSEpoch is the origin of GPS time, 1980-01-06 00:00:00 UTC, which is
+// 00:00:19 in TAI
+var GPSEpoch = TAI{sec: 8040*Day + GPSOffset}
+
+// FromGPS returns the TAI instant tow seconds into the GPS week, counted from
+// GPSEpoch without rollover.  tow need not be within [0, 604800).
+func FromGPS(week int, tow float64) TAI {
+	return GPSEpoch.Add(int64(week)*GPSWeek, 0).AddDuration(durationFromSeconds(tow))
+}
+
+// AsGPS returns the GPS week of t, counted from GPSEpoch without rollover,
+// and the time of week in seconds.  tow has the precision of a float64, about
+// 0.1 ns at the end of the week; it is the inverse of FromGPS.
+func (t TAI) AsGPS() (week int, tow float64) {
+	w, sow := floorDiv(t.sec-GPSEpoch.sec, GPSWeek)
+	return int(w), float64(sow) + float64(t.asec)/1e18
+}
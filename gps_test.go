@@ -0,0 +1,47 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestGPSEpoch(t *testing.T) {
+	if exp := tai.Date(1980, 1, 6).Add(19, 0); !tai.GPSEpoch.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp, tai.GPSEpoch)
+	}
+	// TAI-UTC was 19 s in 1980, so the epochs agree in UTC
+	if got := tai.GPSEpoch.AsTime(); !got.Equal(time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected 1980-01-06 UTC, got %v", got)
+	}
+}
+
+func TestGPS(t *testing.T) {
+	cases := []struct {
+		descr string
+		week  int
+		tow   float64
+		utc   time.Time
+	}{
+		{"Epoch", 0, 0, time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)},
+		// GPS led UTC by 18 s from 2017
+		{"2024", 2321, 302400.5, time.Date(2024, 7, 3, 11, 59, 42, 5e8, time.UTC)},
+		{"BeforeEpoch", -1, 604799, time.Date(1980, 1, 5, 23, 59, 59, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			ta := tai.FromGPS(tc.week, tc.tow)
+			if got := ta.AsTime(); !got.Equal(tc.utc) {
+				t.Fatalf("expected %v, got %v", tc.utc, got)
+			}
+			week, tow := ta.AsGPS()
+			if week != tc.week || tow != tc.tow {
+				t.Fatalf("expected week %d, %v s, got week %d, %v s", tc.week, tc.tow, week, tow)
+			}
+		})
+	}
+	if a, b := tai.FromGPS(1, 0), tai.FromGPS(0, 604800); !a.Eq(b) {
+		t.Fatalf("expected a time of week past the end to carry into the next week, got %+v and %+v", a, b)
+	}
+}
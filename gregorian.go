@@ -1,10 +1,18 @@
 package tai
 
+import "fmt"
+
 // maxint64 / seconds per year = 292277024626
 // 292,277,024,626
 // year 292 billion is when this becomes invalid
 // (perfectly fine)
 
+// Month is a month of the year, January == 1
+//
+// The month constants are untyped, so that they may be used both as a Month
+// and as the int month arguments of e.g. Date and DaysInMonth.
+type Month int
+
 const (
 	January = iota + 1
 	February
@@ -19,14 +27,23 @@ const (
 	November
 	December
 )
+
+// Weekday is a day of the week, numbered the same as WeekdayFromDays with
+// Sunday == 0
+type Weekday int
+
+// The weekday constants were previously untyped and numbered from Monday == 0,
+// which disagreed with WeekdayFromDays and Format.  They are now of type
+// Weekday and numbered from Sunday == 0; code which relied upon the old values
+// must be updated.
 const (
-	Monday = iota
+	Sunday Weekday = iota
+	Monday
 	Tuesday
 	Wednesday
 	Thursday
 	Friday
 	Saturday
-	Sunday
 )
 
 var (
@@ -60,22 +77,6 @@ var (
 		30,
 		31,
 	}
-	daysBeforeNonLeapMonth = [...]int{
-		0,       // not a month
-		0,       // January
-		31,      // February
-		31 + 28, // ...
-		31 + 28 + 31,
-		31 + 28 + 31 + 30,
-		31 + 28 + 31 + 30 + 31,
-		31 + 28 + 31 + 30 + 31 + 30,
-		31 + 28 + 31 + 30 + 31 + 30 + 31,
-		31 + 28 + 31 + 30 + 31 + 30 + 31 + 31,
-		31 + 28 + 31 + 30 + 31 + 30 + 31 + 31 + 30,
-		31 + 28 + 31 + 30 + 31 + 30 + 31 + 31 + 30 + 31,
-		31 + 28 + 31 + 30 + 31 + 30 + 31 + 31 + 30 + 31 + 30,
-		31 + 28 + 31 + 30 + 31 + 30 + 31 + 31 + 30 + 31 + 30 + 31,
-	}
 	monthNamesFull = [...]string{
 		"not a month",
 		"January",
@@ -174,63 +175,133 @@ Thank you, Howard!
 */
 
 // DaysFromCivil returns the number of days in the Gregorian calendar since
-// Jan 1, 1958 from a year, month, and day
+// Jan 1, 1958 from a year, month, and day.  On 32-bit platforms, dates more
+// than about 5.8 million years from 1958 overflow int; see DaysFromCivil64.
 func DaysFromCivil(y, m, d int) int {
+	return int(DaysFromCivil64(y, m, d))
+}
+
+// DaysFromCivil64 is DaysFromCivil with an int64 result, which does not
+// overflow on 32-bit platforms
+func DaysFromCivil64(y, m, d int) int64 {
+	yy := int64(y)
 	if m <= 2 {
-		y--
+		yy--
 	}
-	var era, doy int
-	if y >= 0 {
-		era = y / eraYears
+	var era int64
+	if yy >= 0 {
+		era = yy / eraYears
 	} else {
-		era = (y - eraYearsm1) / eraYears
+		era = (yy - eraYearsm1) / eraYears
 	}
-	yoe := y - era*eraYears
-	if m > 2 {
-		m -= 3
+	yoe := yy - era*eraYears
+	mp := int64(m)
+	if mp > 2 {
+		mp -= 3
 	} else {
-		m += 9
+		mp += 9
 	}
-	doy = (153*m+2)/5 + d - 1
+	doy := (153*mp+2)/5 + int64(d) - 1
 	doe := yoe*yearDays + yoe/4 - yoe/100 + doy
 	return era*eraDays + doe - epochDays
 }
 
 // CivilFromDays converts the number of days in the internal representation
-// to a day in the civil (Gregorian) calendar
+// to a day in the civil (Gregorian) calendar.  On 32-bit platforms, the day
+// count of dates more than about 5.8 million years from 1958 overflows int;
+// see CivilFromDays64.
 func CivilFromDays(days int) (y, m, d int) {
+	return CivilFromDays64(int64(days))
+}
+
+// CivilFromDays64 is CivilFromDays with an int64 day count, which does not
+// overflow on 32-bit platforms
+func CivilFromDays64(days int64) (y, m, d int) {
 	days += epochDays
-	var era, doe, yoe int
+	var era int64
 	if days >= 0 {
 		era = days
 	} else {
 		era = days - eraDaysm1
 	}
 	era /= eraDays
-	doe = days - era*eraDays
-	yoe = (doe - doe/1460 + doe/36524 - doe/146096) / 365
-	y = yoe + era*eraYears
+	doe := days - era*eraDays
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
 	doy := doe - (365*yoe + yoe/4 - yoe/100)
 	mp := (5*doy + 2) / 153
-	d = doy - (153*mp+2)/5 + 1
+	d = int(doy - (153*mp+2)/5 + 1)
 	if mp < 10 {
-		m = mp + 3
+		m = int(mp + 3)
 	} else {
-		m = mp - 9
+		m = int(mp - 9)
 	}
+	y = int(yoe + era*eraYears)
 	if m <= 2 {
 		y++
 	}
 	return
 }
 
-// WeekFromDays returns the weekday number in the common programming,
-// ISO-incompatible notation where 0 == sunday, 6 == sat; not ISO (0 == monday)
+// WeekdayFromDays returns the day of the week of the given day since the
+// epoch, 0==Sunday
 func WeekdayFromDays(days int) int {
-	if days >= -4 {
-		return (days + 4) % 7
+	return weekdayFromDays64(int64(days))
+}
+
+// weekdayFromDays64 is WeekdayFromDays with an int64 day count
+func weekdayFromDays64(days int64) int {
+	// Jan 1, 1958 (day zero) was a Wednesday
+	if days >= -3 {
+		return int((days + 3) % 7)
 	}
-	return (days+5)%7 + 6
+	return int((days+4)%7 + 6)
+}
+
+// String returns the English name of the month, e.g. January
+func (m Month) String() string {
+	if !m.Valid() {
+		return fmt.Sprintf("Month(%d)", int(m))
+	}
+	return monthNamesFull[m]
+}
+
+// Valid returns true if m is in the range [January, December]
+func (m Month) Valid() bool {
+	return m >= January && m <= December
+}
+
+// Next returns the month after m; the month after December is January
+func (m Month) Next() Month {
+	if m >= December {
+		return January
+	}
+	return m + 1
+}
+
+// Prev returns the month before m; the month before January is December
+func (m Month) Prev() Month {
+	if m <= January {
+		return December
+	}
+	return m - 1
+}
+
+// String returns the English name of the day, e.g. Monday
+func (d Weekday) String() string {
+	if d < Sunday || d > Saturday {
+		return fmt.Sprintf("Weekday(%d)", int(d))
+	}
+	return weekdayNames[d]
+}
+
+// Next returns the day after d
+func (d Weekday) Next() Weekday {
+	return Weekday(NextWeekday(int(d)))
+}
+
+// Prev returns the day before d
+func (d Weekday) Prev() Weekday {
+	return Weekday(PrevWeekday(int(d)))
 }
 
 // WeekdayDifference computes the number of days between weekday d1, d2.
@@ -261,15 +332,39 @@ func PrevWeekday(wd int) int {
 }
 
 // DaysFromSecsEpoch returns the number of days in the internal representation
-// since the epoch in seconds
+// since the epoch in seconds, truncated toward zero
+//
+// Deprecated: the result overflows int on 32-bit platforms for instants more
+// than about 5.8 million years from 1958, and instants before the epoch are
+// counted in the following day.  Use DaysFromSecsEpoch64.
 func DaysFromSecsEpoch(secs int64) int {
 	return int(secs / Day)
 }
 
+// SecsEpochFromDays returns the number of seconds since the epoch at the
+// start of the given day
+//
+// Deprecated: days can not count the full range of TAI in an int on 32-bit
+// platforms.  Use SecsEpochFromDays64.
 func SecsEpochFromDays(days int) int64 {
 	return int64(days) * Day
 }
 
+// DaysFromSecsEpoch64 returns the day containing the instant secs seconds
+// after the epoch, and is exact over the full range of TAI on every platform.
+// Unlike DaysFromSecsEpoch, instants before the epoch fall on the day they are
+// within, e.g. -1 is in day -1.
+func DaysFromSecsEpoch64(secs int64) int64 {
+	d, _ := floorDiv(secs, Day)
+	return d
+}
+
+// SecsEpochFromDays64 returns the number of seconds since the epoch at the
+// start of the given day
+func SecsEpochFromDays64(days int64) int64 {
+	return days * Day
+}
+
 // DaysInMonth returns the number of days in the given month and year
 func DaysInMonth(m, y int) int {
 	ily := IsLeapYear(y)
@@ -283,25 +378,34 @@ func DaysInMonth(m, y int) int {
 type Gregorian struct {
 	Asec  int64
 	Year  int
-	Month int
+	Month Month
 	Day   int
 	Hour  int
 	Min   int
 	Sec   int
 }
 
-// Before returns true if g is before o
+// Weekday returns the day of the week of g's date
+func (g Gregorian) Weekday() Weekday {
+	return Weekday(weekdayFromDays64(DaysFromCivil64(g.Year, int(g.Month), g.Day)))
+}
+
+// YearDay returns the ordinal day of the year of g's date, in [1, 366]
+func (g Gregorian) YearDay() int {
+	return int(DaysFromCivil64(g.Year, int(g.Month), g.Day)-DaysFromCivil64(g.Year, 1, 1)) + 1
+}
+
+// Before returns true if g is before o.  The fields are compared directly,
+// without conversion to TAI, and so must be in their normal ranges as from
+// AsGregorian.
 func (g Gregorian) Before(o Gregorian) bool {
-	t1 := FromGregorian(g)
-	t2 := FromGregorian(o)
-	return t1.Before(t2)
+	return g.compare(o) < 0
 }
 
-// After returns true if g is after o
+// After returns true if g is after o.  The fields are compared directly, as
+// by Before.
 func (g Gregorian) After(o Gregorian) bool {
-	t1 := FromGregorian(g)
-	t2 := FromGregorian(o)
-	return t1.After(t2)
+	return g.compare(o) > 0
 }
 
 // Eq returns true if g and o represent the same instant in time
@@ -314,3 +418,56 @@ func (g Gregorian) Eq(o Gregorian) bool {
 		g.Min == o.Min &&
 		g.Sec == o.Sec)
 }
+
+// compare returns -1, 0, or +1 as g is before, equal to, or after o
+func (g Gregorian) compare(o Gregorian) int {
+	a := [...]int64{int64(g.Year), int64(g.Month), int64(g.Day), int64(g.Hour), int64(g.Min), int64(g.Sec), g.Asec}
+	b := [...]int64{int64(o.Year), int64(o.Month), int64(o.Day), int64(o.Hour), int64(o.Min), int64(o.Sec), o.Asec}
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// Add returns g offset by the given numbers of years, months, and days, and
+// then by d, computed in the calendar without conversion to TAI.
+//
+// years and months are added first; if the day of the month does not exist in
+// the resulting month, it is clamped to the last day, so that one month after
+// January 31 is the last day of February.  This is the inverse of
+// DiffCalendar: adding the years, months, and days of DiffCalendar(a, b) to
+// a, and then its time of day as a Duration, yields b.
+func (g Gregorian) Add(years, months, days int, d Duration) Gregorian {
+	y64, m0 := floorDiv(int64(g.Year)*12+int64(g.Month)-1+int64(years)*12+int64(months), 12)
+	y, m := int(y64), int(m0)+1
+	day := g.Day
+	if dim := DaysInMonth(m, y); day > dim {
+		day = dim
+	}
+	tod := Dur(int64(g.Hour)*Hour+int64(g.Min)*Minute+int64(g.Sec), g.Asec).Add(d)
+	sec, asec := tod.Parts()
+	carry, rem := floorDiv(sec, Day)
+	return gregorianFromDays(DaysFromCivil64(y, m, day)+int64(days)+carry, rem, asec)
+}
+
+// unixFromCivil returns the UNIX time of the UTC wall time y-m-d h:mi:s
+func unixFromCivil(y, m, d, h, mi, s int) int64 {
+	secs := SecsEpochFromDays64(DaysFromCivil64(y, m, d)) - unixEpochSkew
+	return secs + int64(h*Hour+mi*Minute+s)
+}
+
+// civilFromUnix breaks a UNIX time into its UTC wall time
+func civilFromUnix(secs int64) (y, m, d, h, mi, s int) {
+	days, rem := floorDiv(secs+unixEpochSkew, Day)
+	y, m, d = CivilFromDays64(days)
+	h = int(rem / Hour)
+	rem %= Hour
+	mi = int(rem / Minute)
+	s = int(rem % Minute)
+	return
+}
@@ -3,6 +3,7 @@ package tai_test
 import (
 	"fmt"
 	"testing"
+	"testing/quick"
 
 	"github.com/brandondube/tai"
 )
@@ -64,10 +65,175 @@ func TestHammer(t *testing.T) {
 			for d := 1; d <= e; d++ {
 				ta := tai.Date(y, m, d)
 				g := ta.AsGregorian()
-				if g.Year != y || g.Month != m || g.Day != d {
+				if g.Year != y || g.Month != tai.Month(m) || g.Day != d {
 					t.Fatal(fmt.Sprintf("input Y=%d, m=%d, d=%d failed, got Y=%d, m=%d, d=%d", y, m, d, g.Year, g.Month, g.Day))
 				}
 			}
 		}
 	}
 }
+
+func TestWeekdayFromDays(t *testing.T) {
+	cases := []struct {
+		descr string
+		y     int
+		m     int
+		d     int
+		exp   int
+	}{
+		{"Epoch", 1958, 1, 1, 3},
+		{"UnixEpoch", 1970, 1, 1, 4},
+		{"Monday", 2024, 7, 1, 1},
+		{"SundayBeforeEpoch", 1957, 12, 29, 0},
+		{"SaturdayBeforeEpoch", 1957, 12, 28, 6},
+		{"FarPast", 1582, 10, 15, 5},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			d := tai.DaysFromCivil(tc.y, tc.m, tc.d)
+			if wd := tai.WeekdayFromDays(d); wd != tc.exp {
+				t.Fatalf("expected weekday %d, got %d", tc.exp, wd)
+			}
+		})
+	}
+}
+
+// WeekdayFromDays once counted from a Thursday epoch, naming every day as
+// the one after it
+func TestWeekdayFromDaysEpochOffset(t *testing.T) {
+	cases := []struct {
+		descr string
+		days  int
+		exp   tai.Weekday
+		old   tai.Weekday
+	}{
+		{"Epoch", 0, tai.Wednesday, tai.Thursday},
+		{"DayBeforeEpoch", -1, tai.Tuesday, tai.Wednesday},
+		{"WeekBeforeEpoch", -7, tai.Wednesday, tai.Thursday},
+		{"UnixEpoch", 4383, tai.Thursday, tai.Friday},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if wd := tai.Weekday(tai.WeekdayFromDays(tc.days)); wd != tc.exp {
+				t.Fatalf("expected %v, got %v (formerly %v)", tc.exp, wd, tc.old)
+			}
+		})
+	}
+}
+
+func TestMonth(t *testing.T) {
+	if s := tai.Month(tai.September).String(); s != "September" {
+		t.Fatalf("expected September, got %s", s)
+	}
+	if m := tai.Month(tai.December).Next(); m != tai.January {
+		t.Fatalf("expected January after December, got %v", m)
+	}
+	if m := tai.Month(tai.January).Prev(); m != tai.December {
+		t.Fatalf("expected December before January, got %v", m)
+	}
+	if tai.Month(0).Valid() || tai.Month(13).Valid() {
+		t.Fatal("out of range month reported as valid")
+	}
+	if m := tai.Date(2024, tai.July, 4).Month(); m != tai.July {
+		t.Fatalf("expected July, got %v", m)
+	}
+}
+
+func TestFromGregorianTimeOfDay(t *testing.T) {
+	g := tai.Gregorian{Year: 2024, Month: tai.July, Day: 1, Hour: 13, Min: 45, Sec: 10, Asec: 5}
+	exp := tai.Date(2024, 7, 1).AddHMS(13, 45, 10).Add(0, 5)
+	if got := tai.FromGregorian(g); !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp.AsGregorian(), got.AsGregorian())
+	}
+}
+
+func TestGregorianBeforeAfter(t *testing.T) {
+	base := tai.Date(2024, 7, 1).AddHMS(12, 0, 0)
+	cases := []struct {
+		descr string
+		a, b  tai.TAI
+	}{
+		{"Asec", base, base.Add(0, 1)},
+		{"Second", base, base.Add(1, 0)},
+		{"Hour", base, base.AddHMS(1, 0, 0)},
+		{"LaterTimeEarlierDay", base.AddHMS(11, 0, 0), base.Add(tai.Day, 0).AddHMS(-11, 0, 0)},
+		{"Year", tai.Date(1957, 12, 31), tai.Date(1958, 1, 1)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			a, b := tc.a.AsGregorian(), tc.b.AsGregorian()
+			if !a.Before(b) || a.After(b) || !b.After(a) || b.Before(a) {
+				t.Fatalf("expected %+v before %+v", a, b)
+			}
+			if a.Before(a) || a.After(a) {
+				t.Fatal("a Gregorian is neither before nor after itself")
+			}
+		})
+	}
+}
+
+func TestGregorianAdd(t *testing.T) {
+	cases := []struct {
+		descr               string
+		start               tai.TAI
+		years, months, days int
+		d                   tai.Duration
+		exp                 tai.TAI
+	}{
+		{"Year", tai.Date(2023, 3, 1), 1, 0, 0, tai.Duration{}, tai.Date(2024, 3, 1)},
+		{"MonthClamps", tai.Date(2024, 1, 31), 0, 1, 0, tai.Duration{}, tai.Date(2024, 2, 29)},
+		{"LeapDayNextYear", tai.Date(2024, 2, 29), 1, 0, 0, tai.Duration{}, tai.Date(2025, 2, 28)},
+		{"MonthsCarry", tai.Date(2024, 11, 15), 0, 14, 0, tai.Duration{}, tai.Date(2026, 1, 15)},
+		{"NegativeMonths", tai.Date(2024, 1, 15), 0, -1, 0, tai.Duration{}, tai.Date(2023, 12, 15)},
+		{"Days", tai.Date(2024, 2, 28), 0, 0, 2, tai.Duration{}, tai.Date(2024, 3, 1)},
+		{"DurationCarries", tai.Date(2024, 12, 31).AddHMS(23, 0, 0), 0, 0, 0, tai.Dur(2*tai.Hour, 5), tai.Date(2025, 1, 1).AddHMS(1, 0, 0).Add(0, 5)},
+		{"NegativeDuration", tai.Date(1958, 1, 1), 0, 0, 0, tai.Dur(0, -1), tai.Tai(0, -1)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got := tc.start.AsGregorian().Add(tc.years, tc.months, tc.days, tc.d)
+			if exp := tc.exp.AsGregorian(); got != exp {
+				t.Fatalf("expected %+v, got %+v", exp, got)
+			}
+		})
+	}
+}
+
+func TestGregorianAddInvertsDiffCalendar(t *testing.T) {
+	f := func(a, b tai.TAI) bool {
+		if b.Before(a) {
+			a, b = b, a
+		}
+		d := tai.DiffCalendar(a, b)
+		tod := tai.Dur(int64(d.Hours)*tai.Hour+int64(d.Minutes)*tai.Minute+int64(d.Seconds), d.Asec)
+		return a.AsGregorian().Add(d.Years, d.Months, d.Days, tod) == b.AsGregorian()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDays64(t *testing.T) {
+	if d := tai.DaysFromSecsEpoch64(-1); d != -1 {
+		t.Errorf("expected the second before the epoch to be in day -1, got %d", d)
+	}
+	if d := tai.DaysFromSecsEpoch64(tai.SecsEpochFromDays64(-5)); d != -5 {
+		t.Errorf("expected day -5, got %d", d)
+	}
+	// beyond the range of a 32-bit day count
+	const year = 100000000
+	days := tai.DaysFromCivil64(year, 3, 1)
+	if days <= 1<<31 {
+		t.Fatalf("expected more than 2^31 days to year %d, got %d", year, days)
+	}
+	if y, m, d := tai.CivilFromDays64(days); y != year || m != 3 || d != 1 {
+		t.Errorf("expected %d-03-01, got %d-%02d-%02d", year, y, m, d)
+	}
+	g := tai.Date(year, 3, 1).Add(3600, 0).AsGregorian()
+	if g.Year != year || g.Month != tai.March || g.Day != 1 || g.Hour != 1 {
+		t.Errorf("expected %d-03-01 01:00, got %+v", year, g)
+	}
+	if exp := tai.Weekday(tai.WeekdayFromDays(int(days % 7))); g.Weekday() != exp {
+		t.Errorf("expected %v, got %v", exp, g.Weekday())
+	}
+}
@@ -0,0 +1,118 @@
+package tai
+
+import "math/bits"
+
+// signShift shifts an int right to all ones if it is negative, and zero
+// otherwise; signShift64 does the same for an int64
+const (
+	signShift   = bits.UintSize - 1
+	signShift64 = 63
+)
+
+// DaysFromCivilBatch is DaysFromCivil for each element of the slices,
+// days[i] = DaysFromCivil(y[i], m[i], d[i]).  The loop has no branches, so
+// that the compiler may pipeline it, and it divides by constants without
+// sign corrections where the operands are known to be non-negative.  On
+// 32-bit platforms, dates more than about 5.8 million years from 1958
+// overflow int; see DaysFromCivilBatch64.
+//
+// DaysFromCivilBatch panics if the slices are not all the same length.
+func DaysFromCivilBatch(days, y, m, d []int) {
+	n := len(days)
+	if len(y) != n || len(m) != n || len(d) != n {
+		panic("tai.DaysFromCivilBatch: slices have different lengths")
+	}
+	// the re-slicing lets the compiler eliminate the bounds checks
+	y, m, d = y[:n], m[:n], d[:n]
+	for i := range days {
+		yy, mm := y[i], m[i]
+		// carry is -1 for January and February, which belong to the
+		// previous year of a calendar that begins in March
+		carry := (mm - 3) >> signShift
+		yy += carry
+		era := (yy - eraYearsm1&(yy>>signShift)) / eraYears
+		yoe := uint(yy - era*eraYears)
+		mp := uint(mm - 3 - 12*carry)
+		doy := (153*mp+2)/5 + uint(d[i]) - 1
+		doe := yoe*yearDays + yoe/4 - yoe/100 + doy
+		days[i] = era*eraDays + int(doe) - epochDays
+	}
+}
+
+// CivilFromDaysBatch is CivilFromDays for each element of days,
+// y[i], m[i], d[i] = CivilFromDays(days[i]).  Like DaysFromCivilBatch, the
+// loop has no branches.  On 32-bit platforms, the day count of dates more
+// than about 5.8 million years from 1958 overflows int; see
+// CivilFromDaysBatch64.
+//
+// CivilFromDaysBatch panics if the slices are not all the same length.
+func CivilFromDaysBatch(y, m, d, days []int) {
+	n := len(days)
+	if len(y) != n || len(m) != n || len(d) != n {
+		panic("tai.CivilFromDaysBatch: slices have different lengths")
+	}
+	y, m, d = y[:n], m[:n], d[:n]
+	for i, z := range days {
+		z += epochDays
+		era := (z - eraDaysm1&(z>>signShift)) / eraDays
+		// the day, year, and month of the era are non-negative; unsigned
+		// division by a constant is cheaper
+		doe := uint(z - era*eraDays)
+		yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+		doy := doe - (yearDays*yoe + yoe/4 - yoe/100)
+		mp := int((5*doy + 2) / 153)
+		d[i] = int(doy) - (153*mp+2)/5 + 1
+		// hi is -1 for January and February, the last months of the year of
+		// a calendar that begins in March
+		hi := (9 - mp) >> signShift
+		m[i] = mp + 3 + 12*hi
+		y[i] = int(yoe) + era*eraYears - hi
+	}
+}
+
+// DaysFromCivilBatch64 is DaysFromCivilBatch with int64 results, which do not
+// overflow on 32-bit platforms, days[i] = DaysFromCivil64(y[i], m[i], d[i]).
+//
+// DaysFromCivilBatch64 panics if the slices are not all the same length.
+func DaysFromCivilBatch64(days []int64, y, m, d []int) {
+	n := len(days)
+	if len(y) != n || len(m) != n || len(d) != n {
+		panic("tai.DaysFromCivilBatch64: slices have different lengths")
+	}
+	y, m, d = y[:n], m[:n], d[:n]
+	for i := range days {
+		yy, mm := int64(y[i]), int64(m[i])
+		carry := (mm - 3) >> signShift64
+		yy += carry
+		era := (yy - eraYearsm1&(yy>>signShift64)) / eraYears
+		yoe := uint64(yy - era*eraYears)
+		mp := uint64(mm - 3 - 12*carry)
+		doy := (153*mp+2)/5 + uint64(d[i]) - 1
+		doe := yoe*yearDays + yoe/4 - yoe/100 + doy
+		days[i] = era*eraDays + int64(doe) - epochDays
+	}
+}
+
+// CivilFromDaysBatch64 is CivilFromDaysBatch with int64 day counts, which do
+// not overflow on 32-bit platforms, y[i], m[i], d[i] = CivilFromDays64(days[i]).
+//
+// CivilFromDaysBatch64 panics if the slices are not all the same length.
+func CivilFromDaysBatch64(y, m, d []int, days []int64) {
+	n := len(days)
+	if len(y) != n || len(m) != n || len(d) != n {
+		panic("tai.CivilFromDaysBatch64: slices have different lengths")
+	}
+	y, m, d = y[:n], m[:n], d[:n]
+	for i, z := range days {
+		z += epochDays
+		era := (z - eraDaysm1&(z>>signShift64)) / eraDays
+		doe := uint64(z - era*eraDays)
+		yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+		doy := doe - (yearDays*yoe + yoe/4 - yoe/100)
+		mp := int64((5*doy + 2) / 153)
+		d[i] = int(int64(doy) - (153*mp+2)/5 + 1)
+		hi := (9 - mp) >> signShift64
+		m[i] = int(mp + 3 + 12*hi)
+		y[i] = int(int64(yoe) + era*eraYears - hi)
+	}
+}
@@ -0,0 +1,111 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCivilBatchMatchesScalar(t *testing.T) {
+	var days []int
+	for d := -800000; d <= 800000; d += 37 {
+		days = append(days, d)
+	}
+	days = append(days, -1, 0, 1, -146097, 146097)
+	n := len(days)
+	y, m, d := make([]int, n), make([]int, n), make([]int, n)
+	tai.CivilFromDaysBatch(y, m, d, days)
+	for i, z := range days {
+		ey, em, ed := tai.CivilFromDays(z)
+		if y[i] != ey || m[i] != em || d[i] != ed {
+			t.Fatalf("day %d: expected %d-%d-%d, got %d-%d-%d", z, ey, em, ed, y[i], m[i], d[i])
+		}
+	}
+	back := make([]int, n)
+	tai.DaysFromCivilBatch(back, y, m, d)
+	for i, z := range days {
+		if back[i] != z {
+			t.Fatalf("%d-%d-%d: expected day %d, got %d", y[i], m[i], d[i], z, back[i])
+		}
+		if exp := tai.DaysFromCivil(y[i], m[i], d[i]); back[i] != exp {
+			t.Fatalf("%d-%d-%d: expected day %d as by DaysFromCivil, got %d", y[i], m[i], d[i], exp, back[i])
+		}
+	}
+}
+
+func TestCivilBatch64MatchesScalar(t *testing.T) {
+	var days []int64
+	for d := int64(-800000); d <= 800000; d += 37 {
+		days = append(days, d)
+	}
+	// beyond the range of a 32-bit int
+	days = append(days, -1, 0, 1, -146097, 146097, 1<<31, -1<<31-1, 1<<40, -1<<40)
+	n := len(days)
+	y, m, d := make([]int, n), make([]int, n), make([]int, n)
+	tai.CivilFromDaysBatch64(y, m, d, days)
+	for i, z := range days {
+		ey, em, ed := tai.CivilFromDays64(z)
+		if y[i] != ey || m[i] != em || d[i] != ed {
+			t.Fatalf("day %d: expected %d-%d-%d, got %d-%d-%d", z, ey, em, ed, y[i], m[i], d[i])
+		}
+	}
+	back := make([]int64, n)
+	tai.DaysFromCivilBatch64(back, y, m, d)
+	for i, z := range days {
+		if back[i] != z {
+			t.Fatalf("%d-%d-%d: expected day %d, got %d", y[i], m[i], d[i], z, back[i])
+		}
+	}
+}
+
+func TestCivilBatchLengthMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for slices of different lengths")
+		}
+	}()
+	tai.DaysFromCivilBatch(make([]int, 2), make([]int, 2), make([]int, 2), make([]int, 1))
+}
+
+func benchDays() []int {
+	days := make([]int, 4096)
+	for i := range days {
+		days[i] = 20000 + i*7
+	}
+	return days
+}
+
+func BenchmarkCivilFromDays(b *testing.B) {
+	days := benchDays()
+	y, m, d := make([]int, len(days)), make([]int, len(days)), make([]int, len(days))
+	b.Run("Scalar", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j, z := range days {
+				y[j], m[j], d[j] = tai.CivilFromDays(z)
+			}
+		}
+	})
+	b.Run("Batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tai.CivilFromDaysBatch(y, m, d, days)
+		}
+	})
+}
+
+func BenchmarkDaysFromCivil(b *testing.B) {
+	days := benchDays()
+	y, m, d := make([]int, len(days)), make([]int, len(days)), make([]int, len(days))
+	tai.CivilFromDaysBatch(y, m, d, days)
+	b.Run("Scalar", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range days {
+				days[j] = tai.DaysFromCivil(y[j], m[j], d[j])
+			}
+		}
+	})
+	b.Run("Batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tai.DaysFromCivilBatch(days, y, m, d)
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package tai
+
+import "sort"
+
+// GregorianSlice attaches the methods of sort.Interface to []Gregorian,
+// sorting in chronological order by direct comparison of the fields; see
+// func (Gregorian) Before
+type GregorianSlice []Gregorian
+
+func (s GregorianSlice) Len() int           { return len(s) }
+func (s GregorianSlice) Less(i, j int) bool { return s[i].Before(s[j]) }
+func (s GregorianSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort sorts s in chronological order.  The sort is stable, so records of the
+// same moment retain their order.
+func (s GregorianSlice) Sort() {
+	sort.Stable(s)
+}
+
+// Search returns the index of the first element of s that is not before g, or
+// len(s) if there is none.  s must be sorted.
+func (s GregorianSlice) Search(g Gregorian) int {
+	return sort.Search(len(s), func(i int) bool { return !s[i].Before(g) })
+}
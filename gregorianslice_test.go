@@ -0,0 +1,51 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestGregorianSlice(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	s := tai.GregorianSlice{
+		base.AddHMS(13, 0, 0).AsGregorian(),
+		base.AsGregorian(),
+		tai.Date(1957, 12, 31).AsGregorian(),
+		base.AddHMS(1, 0, 0).Add(0, 1).AsGregorian(),
+		base.AddHMS(1, 0, 0).AsGregorian(),
+	}
+	s.Sort()
+	for i := 1; i < len(s); i++ {
+		if s[i].Before(s[i-1]) {
+			t.Fatalf("not sorted at %d: %+v before %+v", i, s[i], s[i-1])
+		}
+	}
+	cases := []struct {
+		descr string
+		g     tai.Gregorian
+		exp   int
+	}{
+		{"First", tai.Date(1900, 1, 1).AsGregorian(), 0},
+		{"Exact", base.AsGregorian(), 1},
+		{"Between", base.AddHMS(1, 0, 0).Add(0, 1).AsGregorian(), 3},
+		{"AfterAll", tai.Date(2100, 1, 1).AsGregorian(), 5},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := s.Search(tc.g); got != tc.exp {
+				t.Fatalf("expected %d, got %d", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestGregorianSliceStable(t *testing.T) {
+	g := tai.Date(2024, 7, 1).AsGregorian()
+	early := tai.Date(2000, 1, 1).AsGregorian()
+	s := tai.GregorianSlice{g, g, early}
+	s.Sort()
+	if s[0] != early || s[1] != g || s[2] != g {
+		t.Fatalf("expected equal moments to be kept after the earlier one, got %+v", s)
+	}
+}
@@ -0,0 +1,210 @@
+package tai
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// defaultSubBuckets is the SubBuckets of a Histogram in which it is zero
+const defaultSubBuckets = 8
+
+// Histogram accumulates Durations, such as the times between successive stamps
+// of a stream, into buckets of bounded relative width in the manner of an HDR
+// histogram: durations below SubBuckets times Resolution are counted exactly,
+// and each doubling of duration beyond that is split into SubBuckets buckets,
+// so that a bucket is never wider than 1/SubBuckets of the durations in it.
+// Memory does not grow with the number of durations.  It grows with their range
+// and with SubBuckets: there are at most about 130 times SubBuckets buckets of
+// eight bytes, a few kilobytes with the default but megabytes with the largest.
+//
+// The zero value of Histogram counts in buckets of one attosecond with eight
+// per doubling.  Resolution and SubBuckets must not be changed after the first
+// duration is recorded.  Histogram is not safe for concurrent use.
+type Histogram struct {
+	// Resolution is the width of the finest buckets, less than about 18
+	// seconds; zero is one attosecond
+	Resolution Duration
+	// SubBuckets is the number of buckets of each doubling of duration, a
+	// power of two up to 1<<16; zero is 8
+	SubBuckets int
+
+	res      uint64 // Resolution in attoseconds
+	half     int    // SubBuckets, half the number of buckets of unit width
+	counts   []uint64
+	n        uint64
+	negative uint64
+	min, max Duration
+	last     TAI
+	started  bool
+}
+
+// init validates the configuration of h on first use
+func (h *Histogram) init() {
+	if h.res != 0 {
+		return
+	}
+	h.half = h.SubBuckets
+	if h.half == 0 {
+		h.half = defaultSubBuckets
+	}
+	if h.half < 1 || h.half > 1<<16 || h.half&(h.half-1) != 0 {
+		panic(fmt.Sprintf("tai.Histogram: SubBuckets %d is not a power of two up to 65536", h.SubBuckets))
+	}
+	r := h.Resolution
+	if r == (Duration{}) {
+		r = Dur(0, Attosecond)
+	}
+	if r.IsNegative() || r.sec >= 18 {
+		panic(fmt.Sprintf("tai.Histogram: resolution %v out of range", h.Resolution))
+	}
+	h.res = uint64(r.sec)*1e18 + uint64(r.asec)
+}
+
+// Observe records the time since the previous stamp observed, if any.  Stamps
+// out of order are counted by Negative.
+func (h *Histogram) Observe(t TAI) {
+	if h.started {
+		h.Record(t.Sub(h.last))
+	}
+	h.last, h.started = t, true
+}
+
+// Record adds d to the histogram.  Negative durations are not bucketed, and
+// are counted by Negative.
+func (h *Histogram) Record(d Duration) {
+	h.init()
+	if d.IsNegative() {
+		h.negative++
+		return
+	}
+	i := h.index(d)
+	if i >= len(h.counts) {
+		h.counts = append(h.counts, make([]uint64, i+1-len(h.counts))...)
+	}
+	h.counts[i]++
+	if h.n == 0 || d.Less(h.min) {
+		h.min = d
+	}
+	if h.n == 0 || h.max.Less(d) {
+		h.max = d
+	}
+	h.n++
+}
+
+// index returns the bucket of the nonnegative duration d
+func (h *Histogram) index(d Duration) int {
+	// q = d / res, in 128 bits
+	hi, lo := bits.Mul64(uint64(d.sec), 1e18)
+	lo, carry := bits.Add64(lo, uint64(d.asec), 0)
+	hi += carry
+	qhi := hi / h.res
+	qlo, _ := bits.Div64(hi%h.res, lo, h.res)
+	units := 2 * h.half
+	if qhi == 0 && qlo < uint64(units) {
+		return int(qlo)
+	}
+	n := bits.Len64(qlo)
+	if qhi != 0 {
+		n = 64 + bits.Len64(qhi)
+	}
+	// the leading bits of q select the bucket within its doubling
+	k := bits.Len(uint(units)) - 1
+	shift := uint(n - k)
+	m := qlo>>shift | qhi<<(64-shift)
+	if shift >= 64 {
+		m = qhi >> (shift - 64)
+	}
+	return units + int(shift-1)*h.half + int(m) - h.half
+}
+
+// bounds returns the first duration of bucket i and the first of the next
+func (h *Histogram) bounds(i int) (lo, hi Duration) {
+	start, width := big.NewInt(int64(i)), bigOne
+	if units := 2 * h.half; i >= units {
+		j := i - units
+		shift := uint(j/h.half + 1)
+		start = big.NewInt(int64(h.half + j%h.half))
+		start.Lsh(start, shift)
+		width = new(big.Int).Lsh(bigOne, shift)
+	}
+	res := new(big.Int).SetUint64(h.res)
+	end := new(big.Int).Add(start, width)
+	return durationFromBig(start.Mul(start, res)), durationFromBig(end.Mul(end, res))
+}
+
+// Count returns the number of durations recorded, excluding negative ones
+func (h *Histogram) Count() uint64 {
+	return h.n
+}
+
+// Negative returns the number of negative durations recorded, such as from
+// stamps observed out of order
+func (h *Histogram) Negative() uint64 {
+	return h.negative
+}
+
+// Min returns the least duration recorded, exactly
+func (h *Histogram) Min() Duration {
+	return h.min
+}
+
+// Max returns the greatest duration recorded, exactly
+func (h *Histogram) Max() Duration {
+	return h.max
+}
+
+// Quantile returns an upper bound of the q quantile of the durations recorded,
+// for q in [0, 1]: the end of the bucket that holds it, but no more than Max.
+// Quantile(0.99) is the 99th percentile.  An empty histogram returns zero.
+func (h *Histogram) Quantile(q float64) Duration {
+	if h.n == 0 {
+		return Duration{}
+	}
+	rank := uint64(math.Ceil(q * float64(h.n)))
+	if rank < 1 {
+		rank = 1
+	}
+	var seen uint64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			_, hi := h.bounds(i)
+			if h.max.Less(hi) {
+				return h.max
+			}
+			return hi
+		}
+	}
+	return h.max
+}
+
+// HistogramBucket is a bucket of a Histogram, holding the durations in
+// [Lo, Hi)
+type HistogramBucket struct {
+	Lo, Hi Duration
+	Count  uint64
+}
+
+// Buckets returns the nonempty buckets of h, in order of duration
+func (h *Histogram) Buckets() []HistogramBucket {
+	var out []HistogramBucket
+	for i, c := range h.counts {
+		if c == 0 {
+			continue
+		}
+		lo, hi := h.bounds(i)
+		out = append(out, HistogramBucket{Lo: lo, Hi: hi, Count: c})
+	}
+	return out
+}
+
+// Reset empties h and forgets the last stamp observed, keeping its
+// configuration
+func (h *Histogram) Reset() {
+	h.counts = h.counts[:0]
+	h.n, h.negative = 0, 0
+	h.min, h.max = Duration{}, Duration{}
+	h.last, h.started = TAI{}, false
+}
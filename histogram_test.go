@@ -0,0 +1,101 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestHistogramExact(t *testing.T) {
+	var h tai.Histogram
+	for _, asec := range []int64{0, 1, 1, 15} {
+		h.Record(tai.Dur(0, asec))
+	}
+	exp := []tai.HistogramBucket{
+		{Lo: tai.Dur(0, 0), Hi: tai.Dur(0, 1), Count: 1},
+		{Lo: tai.Dur(0, 1), Hi: tai.Dur(0, 2), Count: 2},
+		{Lo: tai.Dur(0, 15), Hi: tai.Dur(0, 16), Count: 1},
+	}
+	got := h.Buckets()
+	if len(got) != len(exp) {
+		t.Fatalf("expected %v, got %v", exp, got)
+	}
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Fatalf("expected %v, got %v", exp, got)
+		}
+	}
+}
+
+func TestHistogramRelativeWidth(t *testing.T) {
+	h := tai.Histogram{Resolution: tai.Dur(0, tai.Nanosecond), SubBuckets: 16}
+	for _, d := range []tai.Duration{
+		tai.Dur(0, 12345*tai.Nanosecond),
+		tai.Dur(0, 999*tai.Microsecond),
+		tai.Dur(3, 5),
+		tai.Dur(17, 0),
+		tai.Dur(1<<40, 0),
+	} {
+		h.Reset()
+		h.Record(d)
+		b := h.Buckets()
+		if len(b) != 1 || b[0].Count != 1 {
+			t.Fatalf("expected one bucket, got %v", b)
+		}
+		if d.Less(b[0].Lo) || !d.Less(b[0].Hi) {
+			t.Fatalf("expected %v in [%v, %v)", d, b[0].Lo, b[0].Hi)
+		}
+		// the bucket is at most 1/16 of its start
+		if width := b[0].Hi.Sub(b[0].Lo); b[0].Lo.Less(width.Mul(16)) {
+			t.Fatalf("bucket [%v, %v) too wide for %v", b[0].Lo, b[0].Hi, d)
+		}
+	}
+}
+
+func TestHistogramObserve(t *testing.T) {
+	var h tai.Histogram
+	h.Resolution = tai.Dur(0, tai.Microsecond)
+	base := tai.Date(2024, 7, 1)
+	for i := int64(0); i < 100; i++ {
+		// every tenth interval is late by half a millisecond
+		late := int64(0)
+		if i%10 == 9 {
+			late = 500 * tai.Microsecond
+		}
+		h.Observe(base.Add(0, i*tai.Microsecond*1000+late))
+	}
+	h.Observe(base)
+	if h.Count() != 99 || h.Negative() != 1 {
+		t.Fatalf("expected 99 intervals and 1 negative, got %d and %d", h.Count(), h.Negative())
+	}
+	if exp := tai.Dur(0, 500*tai.Microsecond); h.Min() != exp {
+		t.Fatalf("expected min %v, got %v", exp, h.Min())
+	}
+	if exp := tai.Dur(0, 1500*tai.Microsecond); h.Max() != exp {
+		t.Fatalf("expected max %v, got %v", exp, h.Max())
+	}
+	if q := h.Quantile(1); q != h.Max() {
+		t.Fatalf("expected the 100th percentile to be the max, got %v", q)
+	}
+	// 1 ms is in the bucket [960 us, 1024 us), 1/8 of the doubling from 512 us
+	if q, exp := h.Quantile(0.5), tai.Dur(0, 1024*tai.Microsecond); q != exp {
+		t.Fatalf("expected median bound %v, got %v", exp, q)
+	}
+}
+
+func TestHistogramInvalid(t *testing.T) {
+	for name, h := range map[string]*tai.Histogram{
+		"SubBuckets": {SubBuckets: 3},
+		"Resolution": {Resolution: tai.Dur(20, 0)},
+		"Negative":   {Resolution: tai.Dur(-1, 0)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("expected a panic")
+				}
+			}()
+			h.Record(tai.Dur(1, 0))
+		})
+	}
+}
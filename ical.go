@@ -0,0 +1,121 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrFloatingTime is returned for a floating iCalendar DATE-TIME under the
+// FloatingReject policy
+var ErrFloatingTime = errors.New("tai: floating iCalendar time is not permitted")
+
+// FloatingPolicy is how a floating iCalendar DATE-TIME, one without a "Z" or
+// a TZID, is mapped to and from an instant
+type FloatingPolicy int
+
+const (
+	// FloatingReject returns ErrFloatingTime
+	FloatingReject FloatingPolicy = iota
+	// FloatingUTC treats the wall time as UTC, as the "Z" form
+	FloatingUTC
+	// FloatingTAI treats the wall time as TAI, as ParseRRule does for UNTIL
+	FloatingTAI
+	// FloatingLocal treats the wall time as the time.Local zone
+	FloatingLocal
+)
+
+// ICalDateTime returns t as an RFC 5545 DATE-TIME in the UTC "Z" form,
+// YYYYMMDDTHHMMSSZ, e.g. 20240701T123456Z.  The time is truncated toward the
+// past to seconds, and a time within a leap second is formatted as the 60th
+// second of the minute, as RFC 5545 permits.
+func (t TAI) ICalDateTime() string {
+	y, mo, d, h, mi, s := t.x509Civil()
+	return string(append(appendICal(make([]byte, 0, 16), y, mo, d, h, mi, s), 'Z'))
+}
+
+// ICalFloating returns t as a floating RFC 5545 DATE-TIME, YYYYMMDDTHHMMSS,
+// with the wall time given by policy.  It is the inverse of ParseICalDateTime.
+func (t TAI) ICalFloating(policy FloatingPolicy) (string, error) {
+	var y, mo, d, h, mi, s int
+	switch policy {
+	case FloatingUTC:
+		y, mo, d, h, mi, s = t.x509Civil()
+	case FloatingTAI:
+		g := t.AsGregorian()
+		y, mo, d, h, mi, s = g.Year, int(g.Month), g.Day, g.Hour, g.Min, g.Sec
+	case FloatingLocal:
+		lt := t.AsTime().In(time.Local)
+		var m time.Month
+		y, m, d = lt.Date()
+		mo = int(m)
+		h, mi, s = lt.Clock()
+	case FloatingReject:
+		return "", ErrFloatingTime
+	default:
+		return "", fmt.Errorf("ICalFloating: unknown policy %d", int(policy))
+	}
+	return string(appendICal(make([]byte, 0, 15), y, mo, d, h, mi, s)), nil
+}
+
+func appendICal(b []byte, y, mo, d, h, mi, s int) []byte {
+	b = appendInt(b, int64(y), 4)
+	b = appendInt(b, int64(mo), 2)
+	b = appendInt(b, int64(d), 2)
+	b = append(b, 'T')
+	b = appendInt(b, int64(h), 2)
+	b = appendInt(b, int64(mi), 2)
+	return appendInt(b, int64(s), 2)
+}
+
+// ParseICalDateTime parses an RFC 5545 DATE-TIME value, such as the value of
+// a DTSTART property.  The UTC "Z" form is converted to TAI using the leap
+// second table; the wall time is resolved as by ResolveUTC with ResolveLatest,
+// so 23:59:60 names a leap second, or the start of the next day if there is
+// none.  A floating DATE-TIME is mapped to an instant by floating.
+//
+// the TZID parameter of a property is not supported; such values are floating
+// in their text, and may be mapped by FloatingLocal where time.Local is the
+// named zone.
+func ParseICalDateTime(s string, floating FloatingPolicy) (TAI, error) {
+	t, err := parseICalDateTime(s, floating)
+	if err != nil {
+		return TAI{}, fmt.Errorf("ParseICalDateTime: %w", err)
+	}
+	return t, nil
+}
+
+func parseICalDateTime(s string, floating FloatingPolicy) (TAI, error) {
+	p := parser{s: s}
+	y, mo, d := p.digits(4), p.digits(2), p.digits(2)
+	p.expect('T')
+	h, mi, sec := p.digits(2), p.digits(2), p.digits(2)
+	utc := p.peek() == 'Z'
+	if utc {
+		p.next()
+	}
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return TAI{}, p.err
+	}
+	if err := validCivil(y, mo, d, h, mi, sec); err != nil {
+		return TAI{}, err
+	}
+	if utc {
+		floating = FloatingUTC
+	}
+	switch floating {
+	case FloatingUTC:
+		g := Gregorian{Year: y, Month: Month(mo), Day: d, Hour: h, Min: mi, Sec: sec}
+		return ResolveUTC(g, ResolveLatest)
+	case FloatingTAI:
+		return Date(y, mo, d).AddHMS(h, mi, sec), nil
+	case FloatingLocal:
+		return FromTime(time.Date(y, time.Month(mo), d, h, mi, sec, 0, time.Local)), nil
+	case FloatingReject:
+		return TAI{}, ErrFloatingTime
+	}
+	return TAI{}, fmt.Errorf("unknown policy %d", int(floating))
+}
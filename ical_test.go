@@ -0,0 +1,92 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestICalDateTime(t *testing.T) {
+	// the examples of RFC 5545 section 3.3.5
+	ta := tai.FromTime(time.Date(1998, 1, 19, 7, 0, 0, 0, time.UTC))
+	if s, exp := ta.ICalDateTime(), "19980119T070000Z"; s != exp {
+		t.Fatalf("expected %s, got %s", exp, s)
+	}
+	got, err := tai.ParseICalDateTime("19980119T070000Z", tai.FloatingReject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(ta) {
+		t.Fatalf("expected %v, got %v", ta, got)
+	}
+}
+
+func TestICalLeapSecond(t *testing.T) {
+	leap := tai.FromTime(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)).Add(-1, 0)
+	if s, exp := leap.ICalDateTime(), "20161231T235960Z"; s != exp {
+		t.Fatalf("expected %s, got %s", exp, s)
+	}
+	got, err := tai.ParseICalDateTime("20161231T235960Z", tai.FloatingReject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(leap) {
+		t.Fatalf("expected the leap second, got %v", got)
+	}
+}
+
+func TestICalFloating(t *testing.T) {
+	defer func(loc *time.Location) { time.Local = loc }(time.Local)
+	time.Local = time.FixedZone("EST", -5*3600)
+	const in = "19980118T230000"
+	cases := []struct {
+		descr  string
+		policy tai.FloatingPolicy
+		exp    tai.TAI
+	}{
+		{"UTC", tai.FloatingUTC, tai.FromTime(time.Date(1998, 1, 18, 23, 0, 0, 0, time.UTC))},
+		{"TAI", tai.FloatingTAI, tai.Date(1998, 1, 18).AddHMS(23, 0, 0)},
+		{"Local", tai.FloatingLocal, tai.FromTime(time.Date(1998, 1, 19, 4, 0, 0, 0, time.UTC))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, err := tai.ParseICalDateTime(in, tc.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(tc.exp) {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+			s, err := got.ICalFloating(tc.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != in {
+				t.Fatalf("expected %s, got %s", in, s)
+			}
+		})
+	}
+	if _, err := tai.ParseICalDateTime(in, tai.FloatingReject); err == nil {
+		t.Fatal("expected the floating time to be rejected")
+	}
+	if _, err := tai.Date(1998, 1, 18).ICalFloating(tai.FloatingReject); err != tai.ErrFloatingTime {
+		t.Fatalf("expected ErrFloatingTime, got %v", err)
+	}
+}
+
+func TestParseICalDateTimeInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"19980119",
+		"19980119T0700Z",
+		"19980119 070000Z",
+		"19980119T070000+0100",
+		"19980230T070000Z",
+		"19980119T070000Z ",
+	} {
+		if _, err := tai.ParseICalDateTime(s, tai.FloatingUTC); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
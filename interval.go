@@ -0,0 +1,22 @@
+package tai
+
+// Interval is the half-open span of time [Start, End)
+type Interval struct {
+	Start, End TAI
+}
+
+// Contains returns true if t is within i
+func (i Interval) Contains(t TAI) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// Empty returns true if i contains no instants, i.e. End is not after Start
+func (i Interval) Empty() bool {
+	return !i.End.After(i.Start)
+}
+
+// Length returns the elapsed time from Start to End, which is negative if End
+// is before Start
+func (i Interval) Length() Duration {
+	return sub(i.End, i.Start)
+}
@@ -0,0 +1,34 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestInterval(t *testing.T) {
+	i := tai.Interval{Start: tai.Date(2024, 1, 1), End: tai.Date(2024, 1, 2)}
+	cases := []struct {
+		descr string
+		t     tai.TAI
+		exp   bool
+	}{
+		{"Start", i.Start, true},
+		{"LastAttosecond", i.End.Add(0, -1), true},
+		{"End", i.End, false},
+		{"Before", i.Start.Add(0, -1), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := i.Contains(tc.t); got != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+		})
+	}
+	if i.Empty() || !(tai.Interval{Start: i.End, End: i.Start}).Empty() || !(tai.Interval{}).Empty() {
+		t.Fatal("Empty is incorrect")
+	}
+	if l := i.Length(); !l.Eq(tai.Dur(tai.Day, 0)) {
+		t.Fatalf("expected a length of one day, got %+v", l)
+	}
+}
@@ -0,0 +1,230 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FromJSMillis returns the TAI time corresponding to a JavaScript Date value,
+// the number of milliseconds since the UNIX epoch in the UTC time system.
+//
+// Like UNIX time, JavaScript Dates do not count leap seconds.  The leap second
+// table is consulted in making the conversion; see func Unix.
+func FromJSMillis(ms int64) TAI {
+	secs, rem := floorDiv(ms, 1e3)
+	return unixAsec(secs, rem*Millisecond)
+}
+
+// ToJSMillis returns t as a JavaScript Date value, the number of milliseconds
+// since the UNIX epoch in the UTC time system.
+//
+// Sub-millisecond time is truncated toward the past, matching the behavior of
+// the Date constructor.
+func (t TAI) ToJSMillis() int64 {
+	secs, asec := t.unix()
+	return secs*1e3 + asec/Millisecond
+}
+
+// TemporalInstant returns t formatted as a TC39 Temporal.Instant string, e.g.
+// 2021-09-03T22:03:56.991894Z
+//
+// The fractional second is printed with nanosecond precision and trailing
+// zeros removed, the same as Temporal.Instant.prototype.toString.  Years
+// outside of [0, 9999] use the expanded six digit form, e.g. -000001.
+func (t TAI) TemporalInstant() string {
+	secs, asec := t.unix()
+	y, m, d, h, mi, s := civilFromUnix(secs)
+	var b strings.Builder
+	b.Grow(30)
+	if y < 0 || y > 9999 {
+		if y < 0 {
+			b.WriteByte('-')
+			y = -y
+		} else {
+			b.WriteByte('+')
+		}
+		fmt.Fprintf(&b, "%06d", y)
+	} else {
+		fmt.Fprintf(&b, "%04d", y)
+	}
+	fmt.Fprintf(&b, "-%02d-%02dT%02d:%02d:%02d", m, d, h, mi, s)
+	if ns := asec / Nanosecond; ns != 0 {
+		frac := fmt.Sprintf("%09d", ns)
+		b.WriteByte('.')
+		b.WriteString(strings.TrimRight(frac, "0"))
+	}
+	b.WriteByte('Z')
+	return b.String()
+}
+
+// ParseTemporalInstant parses a TC39 Temporal.Instant string in the UTC ("Z")
+// offset, such as those produced by Temporal.Instant.prototype.toString.
+//
+// up to nine fractional digits are accepted, and either '.' or ',' may be used
+// as the decimal separator.  Consistent with Temporal, a leap second (:60) is
+// interpreted as the 59th second of the minute, unless Config.Strict is set.
+// In strict mode, the second omitted by a negative leap second is an error as
+// well; otherwise it is the same instant as the start of the next day.
+func ParseTemporalInstant(s string) (TAI, error) {
+	p := parser{s: s}
+	var y int
+	switch p.peek() {
+	case '+', '-':
+		neg := p.next() == '-'
+		y = p.digits(6)
+		if neg {
+			if y == 0 && p.err == nil {
+				return TAI{}, errors.New("ParseTemporalInstant: -000000 is not a valid year")
+			}
+			y = -y
+		}
+	default:
+		y = p.digits(4)
+	}
+	p.expect('-')
+	mo := p.digits(2)
+	p.expect('-')
+	d := p.digits(2)
+	if c := p.next(); c != 'T' && c != 't' && p.err == nil {
+		p.fail("expected date/time separator 'T'")
+	}
+	h := p.digits(2)
+	p.expect(':')
+	mi := p.digits(2)
+	p.expect(':')
+	sec := p.digits(2)
+	var ns int64
+	if c := p.peek(); c == '.' || c == ',' {
+		p.next()
+		ns = p.fraction(9)
+	}
+	if c := p.next(); c != 'Z' && c != 'z' && p.err == nil {
+		p.fail("expected UTC designator 'Z'")
+	}
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return TAI{}, fmt.Errorf("ParseTemporalInstant: %w", p.err)
+	}
+	if sec == 60 {
+		if currentConfig().Strict {
+			return TAI{}, errors.New("ParseTemporalInstant: leap seconds are not permitted in strict mode")
+		}
+		sec = 59
+	}
+	if err := validCivil(y, mo, d, h, mi, sec); err != nil {
+		return TAI{}, fmt.Errorf("ParseTemporalInstant: %w", err)
+	}
+	unix := unixFromCivil(y, mo, d, h, mi, sec)
+	if currentConfig().Strict && inSkippedSecond(unix) {
+		return TAI{}, errors.New("ParseTemporalInstant: the time was omitted by a negative leap second")
+	}
+	return Unix(unix, ns), nil
+}
+
+// validCivil returns an error if the wall time y-m-d h:mi:s does not exist in
+// the Gregorian calendar; a trailing leap second (:60) is allowed
+func validCivil(y, m, d, h, mi, s int) error {
+	if m < 1 || m > 12 {
+		return fmt.Errorf("month %d out of range", m)
+	}
+	if d < 1 || d > DaysInMonth(m, y) {
+		return fmt.Errorf("day %d out of range for month %d", d, m)
+	}
+	if h > 23 {
+		return fmt.Errorf("hour %d out of range", h)
+	}
+	if mi > 59 {
+		return fmt.Errorf("minute %d out of range", mi)
+	}
+	if s > 60 {
+		return fmt.Errorf("second %d out of range", s)
+	}
+	return nil
+}
+
+// parser is a cursor over a timestamp string for the fixed-width parsers.
+// The first error encountered is sticky; subsequent calls return zero values.
+type parser struct {
+	s   string
+	i   int
+	err error
+	// quiet fails with errNoMatch rather than a description of the error,
+	// for callers that try many candidates
+	quiet bool
+}
+
+// errNoMatch is the error of a quiet parser
+var errNoMatch = errors.New("no match")
+
+func (p *parser) fail(msg string) {
+	if p.err == nil {
+		if p.quiet {
+			p.err = errNoMatch
+			return
+		}
+		p.err = fmt.Errorf("%s at offset %d of %q", msg, p.i, p.s)
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.err != nil || p.i >= len(p.s) {
+		return 0
+	}
+	return p.s[p.i]
+}
+
+func (p *parser) next() byte {
+	c := p.peek()
+	if c != 0 {
+		p.i++
+	}
+	return c
+}
+
+func (p *parser) expect(c byte) {
+	if p.next() != c {
+		p.fail(fmt.Sprintf("expected %q", c))
+	}
+}
+
+// digits consumes exactly n decimal digits
+func (p *parser) digits(n int) int {
+	v := 0
+	for k := 0; k < n; k++ {
+		c := p.peek()
+		if c < '0' || c > '9' {
+			p.fail("expected digit")
+			return 0
+		}
+		v = v*10 + int(c-'0')
+		p.i++
+	}
+	return v
+}
+
+// fraction consumes between 1 and max decimal digits of a fractional second
+// and returns them scaled as a max-digit number, e.g. ".5" with a max of 9 is
+// 500000000
+func (p *parser) fraction(max int) int64 {
+	var v int64
+	n := 0
+	for ; n < max; n++ {
+		c := p.peek()
+		if c < '0' || c > '9' {
+			break
+		}
+		v = v*10 + int64(c-'0')
+		p.i++
+	}
+	if n == 0 {
+		p.fail("expected fractional digits")
+		return 0
+	}
+	for ; n < max; n++ {
+		v *= 10
+	}
+	return v
+}
@@ -0,0 +1,103 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestJSMillisRoundTrip(t *testing.T) {
+	cases := []struct {
+		descr string
+		inp   int64
+	}{
+		{"UnixEpoch", 0},
+		{"BeforeUnixEpoch", -1},
+		{"Recent", 1725401036991},
+		{"AfterLeap2016", 1483228800000},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			ta := tai.FromJSMillis(tc.inp)
+			if got := ta.ToJSMillis(); got != tc.inp {
+				t.Fatalf("expected %d, got %d", tc.inp, got)
+			}
+			exp := time.UnixMilli(tc.inp)
+			if !ta.AsTime().Equal(exp) {
+				t.Fatalf("expected %v, got %v", exp, ta.AsTime())
+			}
+		})
+	}
+}
+
+func TestToJSMillisTruncates(t *testing.T) {
+	ta := tai.FromJSMillis(1000).Add(0, 999*tai.Microsecond)
+	if got := ta.ToJSMillis(); got != 1000 {
+		t.Fatalf("expected 1000, got %d", got)
+	}
+}
+
+func TestTemporalInstantFormat(t *testing.T) {
+	cases := []struct {
+		descr string
+		inp   tai.TAI
+		exp   string
+	}{
+		{"WholeSecond", tai.Unix(1630706636, 0), "2021-09-03T22:03:56Z"},
+		{"Micro", tai.Unix(1630706636, 991894000), "2021-09-03T22:03:56.991894Z"},
+		{"Nano", tai.Unix(1630706636, 1), "2021-09-03T22:03:56.000000001Z"},
+		{"ExpandedYear", tai.FromJSMillis(-62198755200000), "-000001-01-01T00:00:00Z"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.inp.TemporalInstant(); got != tc.exp {
+				t.Fatalf("expected %s, got %s", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestParseTemporalInstant(t *testing.T) {
+	cases := []struct {
+		descr string
+		inp   string
+		exp   tai.TAI
+	}{
+		{"WholeSecond", "2021-09-03T22:03:56Z", tai.Unix(1630706636, 0)},
+		{"ShortFraction", "2021-09-03T22:03:56.5Z", tai.Unix(1630706636, 5e8)},
+		{"CommaFraction", "2021-09-03t22:03:56,000000001z", tai.Unix(1630706636, 1)},
+		{"ExpandedYear", "-000001-01-01T00:00:00Z", tai.FromJSMillis(-62198755200000)},
+		{"LeapSecond", "2016-12-31T23:59:60Z", tai.Unix(1483228799, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, err := tai.ParseTemporalInstant(tc.inp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestParseTemporalInstantInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"2021-09-03",
+		"2021-09-03T22:03:56",
+		"2021-09-03T22:03:56+01:00",
+		"2021-13-03T22:03:56Z",
+		"2021-02-29T22:03:56Z",
+		"2021-09-03T22:03:56.1234567890Z",
+		"2021-09-03T22:03:56.Z",
+		"-000000-01-01T00:00:00Z",
+	}
+	for _, inp := range cases {
+		if _, err := tai.ParseTemporalInstant(inp); err == nil {
+			t.Errorf("expected error parsing %q", inp)
+		}
+	}
+}
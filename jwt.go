@@ -0,0 +1,85 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrTokenExpired is returned by JWTClaims.Check when now is at or after
+	// the expiration time, exp
+	ErrTokenExpired = errors.New("tai: token is expired")
+	// ErrTokenNotYetValid is returned by JWTClaims.Check when now is before
+	// the not before time, nbf
+	ErrTokenNotYetValid = errors.New("tai: token is not yet valid")
+	// ErrTokenIssuedInFuture is returned by JWTClaims.Check when the issued at
+	// time, iat, is after now
+	ErrTokenIssuedInFuture = errors.New("tai: token was issued in the future")
+)
+
+// FromNumericDate returns the TAI time of a JWT NumericDate, the number of
+// seconds since the UNIX epoch in the UTC time system.  The leap second table
+// is consulted in making the conversion; see func Unix.
+func FromNumericDate(secs int64) TAI {
+	return Unix(secs, 0)
+}
+
+// ParseNumericDate parses a JWT NumericDate as it appears in JSON, such as a
+// json.Number.  Fractional seconds are exact to the attosecond unless written
+// with an exponent, e.g. 1.7e9, which is parsed as a float64 and must be within
+// the range of int64 seconds.
+func ParseNumericDate(s string) (TAI, error) {
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return TAI{}, fmt.Errorf("ParseNumericDate: %w", err)
+		}
+		// NaN fails both comparisons
+		if !(f >= math.MinInt64 && f < math.MaxInt64) {
+			return TAI{}, fmt.Errorf("ParseNumericDate: %s seconds out of range", s)
+		}
+		return UnixDuration(durationFromSeconds(f)), nil
+	}
+	t, err := parseUnixDecimal(s)
+	if err != nil {
+		return TAI{}, fmt.Errorf("ParseNumericDate: %w", err)
+	}
+	return t, nil
+}
+
+// NumericDate returns t as a JWT NumericDate, truncated toward the past to
+// seconds.  For fractional seconds, see func PAXTime, which has the same form.
+func (t TAI) NumericDate() int64 {
+	secs, _ := t.unix()
+	return secs
+}
+
+// JWTClaims are the registered time claims of a JSON Web Token (RFC 7519).
+// A zero TAI is an absent claim.
+type JWTClaims struct {
+	// Expiry is exp, the time at and after which the token must be rejected
+	Expiry TAI
+	// NotBefore is nbf, the time before which the token must be rejected
+	NotBefore TAI
+	// IssuedAt is iat, the time at which the token was issued
+	IssuedAt TAI
+}
+
+// Check returns an error if the token is not valid at now, allowing leeway for
+// the skew between the clocks of the issuer and of the caller: it is expired
+// at Expiry plus leeway, not yet valid until NotBefore less leeway, and
+// issued in the future if IssuedAt is after now plus leeway.
+func (c JWTClaims) Check(now TAI, leeway Duration) error {
+	switch {
+	case !c.Expiry.IsZero() && !now.Before(c.Expiry.AddDuration(leeway)):
+		return ErrTokenExpired
+	case !c.NotBefore.IsZero() && now.Before(c.NotBefore.AddDuration(leeway.Neg())):
+		return ErrTokenNotYetValid
+	case !c.IssuedAt.IsZero() && c.IssuedAt.After(now.AddDuration(leeway)):
+		return ErrTokenIssuedInFuture
+	}
+	return nil
+}
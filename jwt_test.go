@@ -0,0 +1,85 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestNumericDate(t *testing.T) {
+	ta := tai.FromNumericDate(1719837296)
+	if !ta.Eq(tai.Unix(1719837296, 0)) {
+		t.Fatalf("expected the UNIX time, got %+v", ta)
+	}
+	if n := ta.Add(0, 9e17).NumericDate(); n != 1719837296 {
+		t.Fatalf("expected the NumericDate to be truncated, got %d", n)
+	}
+	cases := []struct {
+		s   string
+		exp tai.TAI
+	}{
+		{"1719837296", tai.Unix(1719837296, 0)},
+		{"1719837296.25", tai.Unix(1719837296, 25e7)},
+		{"1.7e9", tai.Unix(1700000000, 0)},
+		{"17E8", tai.Unix(1700000000, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.s, func(t *testing.T) {
+			got, err := tai.ParseNumericDate(tc.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, got)
+			}
+		})
+	}
+	for _, s := range []string{
+		"",
+		"\"1719837296\"",
+		"1e",
+		"1.5.5",
+		"1e300",
+		"-1e300",
+		"9.3e18",
+		"1e999",
+		"NaN",
+		"Inf",
+		"-Inf",
+	} {
+		if _, err := tai.ParseNumericDate(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestJWTClaimsCheck(t *testing.T) {
+	iat := tai.FromNumericDate(1719837296)
+	c := tai.JWTClaims{IssuedAt: iat, NotBefore: iat, Expiry: iat.Add(3600, 0)}
+	leeway := tai.Dur(30, 0)
+	cases := []struct {
+		descr string
+		now   tai.TAI
+		exp   error
+	}{
+		{"Valid", iat.Add(60, 0), nil},
+		{"WithinLeewayOfIssue", iat.Add(-30, 0), nil},
+		{"BeforeNotBefore", iat.Add(-31, 0), tai.ErrTokenNotYetValid},
+		{"WithinLeewayOfExpiry", iat.Add(3629, 0), nil},
+		{"Expired", iat.Add(3630, 0), tai.ErrTokenExpired},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if err := c.Check(tc.now, leeway); err != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, err)
+			}
+		})
+	}
+	future := tai.JWTClaims{IssuedAt: iat.Add(60, 0)}
+	if err := future.Check(iat, leeway); err != tai.ErrTokenIssuedInFuture {
+		t.Fatalf("expected ErrTokenIssuedInFuture, got %v", err)
+	}
+	if err := (tai.JWTClaims{}).Check(iat, tai.Duration{}); err != nil {
+		t.Fatalf("expected absent claims to pass, got %v", err)
+	}
+}
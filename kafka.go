@@ -0,0 +1,60 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// KafkaNoTimestamp is the timestamp of a Kafka record that has none
+	KafkaNoTimestamp = -1
+
+	// KafkaHeaderKey is the conventional key of the record header holding the
+	// lossless TAI time of a record; see KafkaHeaderValue
+	KafkaHeaderKey = "tai"
+)
+
+// ErrKafkaNoTimestamp is returned when converting a Kafka record timestamp
+// that is absent or invalid
+var ErrKafkaNoTimestamp = errors.New("tai: Kafka record has no timestamp")
+
+// FromKafkaMillis returns the TAI time of a Kafka record timestamp, the number
+// of milliseconds since the UNIX epoch in the UTC time system.  The leap
+// second table is consulted in making the conversion; see func Unix.
+//
+// Kafka timestamps are non-negative; ErrKafkaNoTimestamp is returned for
+// KafkaNoTimestamp and any other negative value.
+func FromKafkaMillis(ms int64) (TAI, error) {
+	if ms < 0 {
+		return TAI{}, ErrKafkaNoTimestamp
+	}
+	return FromJSMillis(ms), nil
+}
+
+// ToKafkaMillis returns t as a Kafka record timestamp, truncated toward the
+// past to milliseconds.  Times before the UNIX epoch are not representable
+// and return KafkaNoTimestamp.
+func (t TAI) ToKafkaMillis() int64 {
+	ms := t.ToJSMillis()
+	if ms < 0 {
+		return KafkaNoTimestamp
+	}
+	return ms
+}
+
+// KafkaHeaderValue returns t encoded for a record header, 16 bytes holding
+// the seconds and attoseconds of t as big-endian int64s; it is the binary
+// encoding of func MarshalBinary.  Alongside the broker's millisecond
+// timestamp, it preserves the full precision of t.
+func (t TAI) KafkaHeaderValue() []byte {
+	return t.AppendBinary(make([]byte, 0, BinaryLen))
+}
+
+// FromKafkaHeaderValue is the inverse of KafkaHeaderValue
+func FromKafkaHeaderValue(b []byte) (TAI, error) {
+	t, err := decodeBinary(b)
+	if err != nil {
+		return TAI{}, fmt.Errorf("FromKafkaHeaderValue: %w", err)
+	}
+	return t, nil
+}
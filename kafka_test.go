@@ -0,0 +1,50 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestKafkaMillis(t *testing.T) {
+	const ms int64 = 1719837296123
+	ta, err := tai.FromKafkaMillis(ms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.FromTime(time.Unix(0, ms*1e6)); !ta.Eq(exp) {
+		t.Fatalf("expected %v, got %v", exp.AsTime(), ta.AsTime())
+	}
+	if got := ta.ToKafkaMillis(); got != ms {
+		t.Fatalf("expected %d, got %d", ms, got)
+	}
+	if _, err := tai.FromKafkaMillis(tai.KafkaNoTimestamp); err != tai.ErrKafkaNoTimestamp {
+		t.Fatalf("expected ErrKafkaNoTimestamp, got %v", err)
+	}
+	if got := tai.Date(1960, 1, 1).ToKafkaMillis(); got != tai.KafkaNoTimestamp {
+		t.Fatalf("expected KafkaNoTimestamp before the UNIX epoch, got %d", got)
+	}
+}
+
+func TestKafkaHeaderValue(t *testing.T) {
+	for _, ta := range []tai.TAI{tai.Tai(-5, 1), tai.Date(2024, 7, 1).Add(0, 123456789123456789)} {
+		got, err := tai.FromKafkaHeaderValue(ta.KafkaHeaderValue())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Eq(ta) {
+			t.Fatalf("expected %+v, got %+v", ta, got)
+		}
+	}
+	if _, err := tai.FromKafkaHeaderValue([]byte{1, 2, 3}); err == nil {
+		t.Fatal("expected error for a short header")
+	}
+	bad := make([]byte, 16)
+	for i := 8; i < 16; i++ {
+		bad[i] = 0xff
+	}
+	if _, err := tai.FromKafkaHeaderValue(bad); err == nil {
+		t.Fatal("expected error for negative attoseconds")
+	}
+}
@@ -0,0 +1,87 @@
+package tai
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// leapGen is incremented whenever the leap second table changes, invalidating
+// the spans held by LeapCaches; it is only written with leaplock held
+var leapGen uint64
+
+// LeapCache caches the span of time around the most recent conversion over
+// which TAI-UTC is constant, so that converting many nearby instants, as is
+// overwhelmingly common, does not acquire the lock of the leap second table.
+// The cache is refreshed when an instant outside the span is converted or the
+// table changes.
+//
+// conversions with a LeapCache are equivalent to Unix and (TAI).Unix.  Under
+// linear smearing, or while the audit trail is enabled, they take the
+// uncached path.
+//
+// The zero value of LeapCache is ready to use.  LeapCache is safe for
+// concurrent use; readers never block.  Goroutines converting instants far
+// apart should each use their own LeapCache, or they will evict one another's
+// spans.
+type LeapCache struct {
+	utc, tai atomic.Value // *leapSpan
+}
+
+// leapSpan is the span [lo, hi) of seconds since the UNIX epoch over which
+// TAI-UTC is skew, as of the table generation gen
+type leapSpan struct {
+	lo, hi, skew int64
+	gen          uint64
+}
+
+// Unix is equivalent to func Unix
+func (c *LeapCache) Unix(seconds, nsec int64) TAI {
+	if currentConfig().Smear != SmearNone || auditing() {
+		return Unix(seconds, nsec)
+	}
+	skew := c.skew(&c.utc, seconds, UTCToTAI)
+	return Tai(seconds+unixEpochSkew+skew, nsec*Nanosecond)
+}
+
+// AsUnix is equivalent to func (TAI) Unix
+func (c *LeapCache) AsUnix(t TAI) (secs, nsecs int64) {
+	if currentConfig().Smear != SmearNone || auditing() {
+		return t.Unix()
+	}
+	secs = t.sec - unixEpochSkew
+	return secs - c.skew(&c.tai, secs, TAIToUTC), t.asec / Nanosecond
+}
+
+// skew is skewUnix, from the span held in v if it contains s
+func (c *LeapCache) skew(v *atomic.Value, s int64, dir ConversionDirection) int64 {
+	if sp, ok := v.Load().(*leapSpan); ok && sp.gen == atomic.LoadUint64(&leapGen) && s >= sp.lo && s < sp.hi {
+		return sp.skew
+	}
+	leaplock.RLock()
+	sp := spanLeaps(leaps, s, dir)
+	sp.gen = atomic.LoadUint64(&leapGen)
+	leaplock.RUnlock()
+	v.Store(sp)
+	return sp.skew
+}
+
+// spanLeaps returns the span of the table around s, which is a UNIX time if
+// dir is UTCToTAI, or the number of TAI seconds since the UNIX epoch if dir is
+// TAIToUTC
+func spanLeaps(leaps []leap, s int64, dir ConversionDirection) *leapSpan {
+	start := func(i int) int64 {
+		if dir == TAIToUTC {
+			return leaps[i].UnixUTC + leaps[i].CumulativeSkew
+		}
+		return leaps[i].UnixUTC
+	}
+	skew, i := skewLeaps(leaps, s, dir)
+	sp := &leapSpan{lo: math.MinInt64, hi: math.MaxInt64, skew: skew}
+	if i >= 0 {
+		sp.lo = start(i)
+	}
+	if i+1 < len(leaps) {
+		sp.hi = start(i + 1)
+	}
+	return sp
+}
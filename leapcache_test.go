@@ -0,0 +1,58 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestLeapCacheAgrees(t *testing.T) {
+	var c tai.LeapCache
+	for _, base := range []int64{0, 78796800, leap2017, 1719835200} {
+		for d := int64(-3); d <= 3; d++ {
+			u := base + d
+			got, exp := c.Unix(u, 5), tai.Unix(u, 5)
+			if !got.Eq(exp) {
+				t.Errorf("Unix(%d): expected %+v, got %+v", u, exp, got)
+			}
+			for _, ta := range []tai.TAI{exp, exp.Add(-1, 0)} {
+				s, ns := c.AsUnix(ta)
+				es, ens := ta.Unix()
+				if s != es || ns != ens {
+					t.Errorf("AsUnix(%+v): expected %d.%09d, got %d.%09d", ta, es, ens, s, ns)
+				}
+			}
+		}
+	}
+}
+
+func TestLeapCacheInvalidatedByTableChange(t *testing.T) {
+	const future = 4102444800 // 2100-01-01
+	var c tai.LeapCache
+	before := c.Unix(future, 0)
+	if err := tai.RegisterLeapSecond(future, 38); err != nil {
+		t.Fatal(err)
+	}
+	defer tai.RemoveLeapSecond(future)
+	if got := c.Unix(future, 0); !got.Eq(before.Add(1, 0)) {
+		t.Fatalf("expected the registered leap second to be applied, got %+v", got)
+	}
+}
+
+func BenchmarkUnixParallel(b *testing.B) {
+	b.Run("RWMutex", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for i := int64(0); pb.Next(); i++ {
+				tai.Unix(1719835200+i%1000, 0)
+			}
+		})
+	})
+	b.Run("LeapCache", func(b *testing.B) {
+		var c tai.LeapCache
+		b.RunParallel(func(pb *testing.PB) {
+			for i := int64(0); pb.Next(); i++ {
+				c.Unix(1719835200+i%1000, 0)
+			}
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package tai
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LeapTableFormat is a language or format into which WriteLeapTable exports
+// the leap second table
+type LeapTableFormat int
+
+const (
+	// LeapTableJSON is a JSON document
+	LeapTableJSON LeapTableFormat = iota
+	// LeapTableC is a C header defining a static array
+	LeapTableC
+	// LeapTablePython is a Python module defining a tuple
+	LeapTablePython
+)
+
+var leapTableFormatNames = [...]string{"json", "c", "python"}
+
+// String returns the name of f, e.g. python
+func (f LeapTableFormat) String() string {
+	if f < LeapTableJSON || f > LeapTablePython {
+		return fmt.Sprintf("LeapTableFormat(%d)", int(f))
+	}
+	return leapTableFormatNames[f]
+}
+
+// ParseLeapTableFormat returns the LeapTableFormat named s, as by String,
+// ignoring case
+func ParseLeapTableFormat(s string) (LeapTableFormat, error) {
+	for i, n := range leapTableFormatNames {
+		if strings.EqualFold(s, n) {
+			return LeapTableFormat(i), nil
+		}
+	}
+	return 0, fmt.Errorf("ParseLeapTableFormat: unknown format %q", s)
+}
+
+// leapTableJSON is the document of LeapTableJSON
+type leapTableJSON struct {
+	Hash        string          `json:"hash"`
+	Expires     int64           `json:"expires"`
+	LeapSeconds []leapEntryJSON `json:"leapSeconds"`
+}
+
+type leapEntryJSON struct {
+	UnixUTC     int64 `json:"unixUTC"`
+	TAIMinusUTC int64 `json:"taiMinusUTC"`
+}
+
+// WriteLeapTable writes the current leap second table to w as source code or
+// data in format f, so that programs in other languages can use the same
+// table as pkg tai.  Each entry is a UNIX time and TAI-UTC from that time
+// onward, as LeapSecond.  The output also carries the identifier of the table
+// (see func HashLeapSeconds), with which the copies can be checked against
+// one another, and the UNIX time at which it expires (see func
+// LeapTableExpiry).
+func WriteLeapTable(w io.Writer, f LeapTableFormat) error {
+	table := LeapSeconds()
+	hash := HashLeapSeconds(table)
+	expires := tableExpiry()
+	if f == LeapTableJSON {
+		doc := leapTableJSON{Hash: hash, Expires: expires, LeapSeconds: make([]leapEntryJSON, len(table))}
+		for i, l := range table {
+			doc.LeapSeconds[i] = leapEntryJSON{l.UnixUTC, l.CumulativeSkew}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(doc)
+	}
+	bw := bufio.NewWriter(w)
+	switch f {
+	case LeapTableC:
+		fmt.Fprintf(bw, "/* Code generated by %s; DO NOT EDIT. */\n\n", modulePath)
+		fmt.Fprint(bw, "#ifndef TAI_LEAP_SECONDS_H\n#define TAI_LEAP_SECONDS_H\n\n#include <stdint.h>\n\n")
+		fmt.Fprintf(bw, "#define TAI_LEAP_TABLE_HASH \"%s\"\n", hash)
+		fmt.Fprintf(bw, "#define TAI_LEAP_TABLE_EXPIRES INT64_C(%d)\n", expires)
+		fmt.Fprintf(bw, "#define TAI_LEAP_SECONDS_LEN %d\n\n", len(table))
+		fmt.Fprint(bw, "/* from unix_utc onward, TAI is tai_minus_utc seconds ahead of UTC */\n")
+		fmt.Fprint(bw, "struct tai_leap_second {\n\tint64_t unix_utc;\n\tint64_t tai_minus_utc;\n};\n\n")
+		fmt.Fprint(bw, "static const struct tai_leap_second tai_leap_seconds[TAI_LEAP_SECONDS_LEN] = {\n")
+		for _, l := range table {
+			fmt.Fprintf(bw, "\t{INT64_C(%d), %d},\n", l.UnixUTC, l.CumulativeSkew)
+		}
+		fmt.Fprint(bw, "};\n\n#endif /* TAI_LEAP_SECONDS_H */\n")
+	case LeapTablePython:
+		fmt.Fprintf(bw, "\"\"\"Code generated by %s; DO NOT EDIT.\"\"\"\n\n", modulePath)
+		fmt.Fprintf(bw, "HASH = %q\n", hash)
+		fmt.Fprintf(bw, "EXPIRES = %d\n\n", expires)
+		fmt.Fprint(bw, "# (unix_utc, tai_minus_utc): from unix_utc onward, TAI is tai_minus_utc\n# seconds ahead of UTC\n")
+		fmt.Fprint(bw, "LEAP_SECONDS = (\n")
+		for _, l := range table {
+			fmt.Fprintf(bw, "    (%d, %d),\n", l.UnixUTC, l.CumulativeSkew)
+		}
+		fmt.Fprint(bw, ")\n")
+	default:
+		return fmt.Errorf("WriteLeapTable: unknown format %d", int(f))
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,96 @@
+package tai_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestWriteLeapTableJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := tai.WriteLeapTable(&buf, tai.LeapTableJSON); err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Hash        string
+		Expires     int64
+		LeapSeconds []struct {
+			UnixUTC     int64
+			TAIMinusUTC int64
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Hash != tai.LeapTableHash() {
+		t.Fatalf("expected hash %s, got %s", tai.LeapTableHash(), doc.Hash)
+	}
+	table := tai.LeapSeconds()
+	if len(doc.LeapSeconds) != len(table) {
+		t.Fatalf("expected %d entries, got %d", len(table), len(doc.LeapSeconds))
+	}
+	for i, l := range table {
+		if e := doc.LeapSeconds[i]; e.UnixUTC != l.UnixUTC || e.TAIMinusUTC != l.CumulativeSkew {
+			t.Fatalf("entry %d: expected %v, got %v", i, l, e)
+		}
+	}
+	if doc.Expires <= table[len(table)-1].UnixUTC {
+		t.Fatalf("expected the table to expire after its last entry, got %d", doc.Expires)
+	}
+}
+
+func TestWriteLeapTableSource(t *testing.T) {
+	table := tai.LeapSeconds()
+	last := table[len(table)-1]
+	cases := []struct {
+		format tai.LeapTableFormat
+		want   []string
+	}{
+		{tai.LeapTableC, []string{
+			"DO NOT EDIT",
+			fmt.Sprintf("#define TAI_LEAP_TABLE_HASH \"%s\"", tai.LeapTableHash()),
+			fmt.Sprintf("#define TAI_LEAP_SECONDS_LEN %d", len(table)),
+			"\t{INT64_C(63072000), 10},",
+			fmt.Sprintf("\t{INT64_C(%d), %d},\n};", last.UnixUTC, last.CumulativeSkew),
+			"#endif",
+		}},
+		{tai.LeapTablePython, []string{
+			"DO NOT EDIT",
+			fmt.Sprintf("HASH = \"%s\"", tai.LeapTableHash()),
+			"    (63072000, 10),",
+			fmt.Sprintf("    (%d, %d),\n)", last.UnixUTC, last.CumulativeSkew),
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.format.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tai.WriteLeapTable(&buf, tc.format); err != nil {
+				t.Fatal(err)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(buf.String(), w) {
+					t.Fatalf("expected output to contain %q, got\n%s", w, buf.String())
+				}
+			}
+		})
+	}
+	if err := tai.WriteLeapTable(&bytes.Buffer{}, tai.LeapTableFormat(9)); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+}
+
+func TestParseLeapTableFormat(t *testing.T) {
+	for _, f := range []tai.LeapTableFormat{tai.LeapTableJSON, tai.LeapTableC, tai.LeapTablePython} {
+		got, err := tai.ParseLeapTableFormat(strings.ToUpper(f.String()))
+		if err != nil || got != f {
+			t.Fatalf("expected %v, got %v, %v", f, got, err)
+		}
+	}
+	if _, err := tai.ParseLeapTableFormat("rust"); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,290 @@
+package tai
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// LeapSecondsFileEnv is the environment variable naming a leap-seconds.list
+// file to load at init, in place of the embedded file.  It provides a path to
+// update the leap second table of a deployed binary without rebuilding it.
+const LeapSecondsFileEnv = "TAI_LEAPSECONDS_FILE"
+
+// ntpUnixSkew is the number of seconds from the NTP epoch, 1900-01-01, to the
+// UNIX epoch
+const ntpUnixSkew = 2208988800
+
+//go:embed leap-seconds.list
+var embeddedLeapSeconds []byte
+
+var (
+	// leapSource describes the origin of the leap second table
+	leapSource atomic.Value // leapTableSource
+	// leapExpires is the UNIX time at which the table's source expires, or
+	// zero if unknown
+	leapExpires int64
+)
+
+type leapTableSource struct {
+	name string
+	err  error
+}
+
+func init() {
+	src := leapTableSource{name: "built-in"}
+	if table, expires, err := parseLeapSecondsList(bytes.NewReader(embeddedLeapSeconds), false); err != nil {
+		src.err = fmt.Errorf("embedded leap-seconds.list: %w", err)
+	} else if err := replaceLeaps(table, expires); err != nil {
+		src.err = fmt.Errorf("embedded leap-seconds.list: %w", err)
+	} else {
+		src.name = "embedded"
+	}
+	if path := os.Getenv(LeapSecondsFileEnv); path != "" {
+		if err := loadLeapSecondsFile(path); err != nil {
+			src.err = fmt.Errorf("%s=%s: %w", LeapSecondsFileEnv, path, err)
+		} else {
+			src = leapTableSource{name: path}
+		}
+	}
+	leapSource.Store(src)
+}
+
+// LeapTableSource returns the origin of the leap second table loaded at init:
+// "built-in" for the table compiled into pkg tai, "embedded" for the embedded
+// leap-seconds.list, or the path named by LeapSecondsFileEnv.
+//
+// if a file could not be loaded, the error is returned and the table is the
+// best of those that could.  Services should check it at startup, as init
+// cannot report it.
+func LeapTableSource() (string, error) {
+	src := leapSource.Load().(leapTableSource)
+	return src.name, src.err
+}
+
+// LoadLeapSecondsFile is LoadLeapSeconds with the contents of the file at path
+func LoadLeapSecondsFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("LoadLeapSecondsFile: %w", err)
+	}
+	defer f.Close()
+	table, expires, err := parseLeapSecondsList(f, false)
+	if err != nil {
+		return fmt.Errorf("LoadLeapSecondsFile: %s: %w", path, err)
+	}
+	if err := mergeLeaps(table, expires); err != nil {
+		return fmt.Errorf("LoadLeapSecondsFile: %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadLeapSeconds reads a file in the leap-seconds.list format published by
+// NIST and the IERS and merges it into the leap second table, so that a
+// long-running process can be kept current without rebuilding it.  The file
+// must agree with and not be shorter than the built-in table, and its hash is
+// verified if present.
+//
+// the file is authoritative up to its last entry; later entries of the current
+// table, such as from a newer file or RegisterLeapSecond, are kept, as is the
+// later of the two expirations.  If the file is invalid, an error is returned
+// and the table is unchanged.
+func LoadLeapSeconds(r io.Reader) error {
+	table, expires, err := parseLeapSecondsList(r, false)
+	if err != nil {
+		return fmt.Errorf("LoadLeapSeconds: %w", err)
+	}
+	if err := mergeLeaps(table, expires); err != nil {
+		return fmt.Errorf("LoadLeapSeconds: %w", err)
+	}
+	return nil
+}
+
+func loadLeapSecondsFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	table, expires, err := parseLeapSecondsList(f, false)
+	if err != nil {
+		return err
+	}
+	return replaceLeaps(table, expires)
+}
+
+// replaceLeaps replaces the leap second table with table, which must agree
+// with and not be shorter than the built-in table
+func replaceLeaps(table []LeapSecond, expires int64) error {
+	leaplock.Lock()
+	defer leaplock.Unlock()
+	if err := checkBuiltin(table); err != nil {
+		return err
+	}
+	next := make([]leap, len(table))
+	for i, l := range table {
+		next[i] = leap(l)
+	}
+	leaps = next
+	atomic.AddUint64(&leapGen, 1)
+	if old := atomic.SwapInt64(&leapExpires, expires); expires > old {
+		rearmStale()
+	}
+	return nil
+}
+
+// mergeLeaps is replaceLeaps, keeping the entries of the current table after
+// the last of table and the later expiration
+func mergeLeaps(table []LeapSecond, expires int64) error {
+	leaplock.Lock()
+	defer leaplock.Unlock()
+	if err := checkBuiltin(table); err != nil {
+		return err
+	}
+	next := make([]leap, len(table), len(table)+len(leaps))
+	for i, l := range table {
+		next[i] = leap(l)
+	}
+	end := table[len(table)-1].UnixUTC
+	for _, l := range leaps {
+		if l.UnixUTC > end {
+			next = append(next, l)
+		}
+	}
+	if err := checkContinuity(next); err != nil {
+		return err
+	}
+	leaps = next
+	atomic.AddUint64(&leapGen, 1)
+	if old := atomic.LoadInt64(&leapExpires); old < expires {
+		atomic.StoreInt64(&leapExpires, expires)
+		rearmStale()
+	}
+	return nil
+}
+
+// checkBuiltin returns an error if table disagrees with or is shorter than
+// the built-in table.  leaplock must be held.
+func checkBuiltin(table []LeapSecond) error {
+	if len(table) < minLeaps {
+		return fmt.Errorf("table has %d entries, older than the built-in table of %d", len(table), minLeaps)
+	}
+	for i := 0; i < minLeaps; i++ {
+		if LeapSecond(leaps[i]) != table[i] {
+			return fmt.Errorf("entry %d, %+v, disagrees with the built-in table", i+1, table[i])
+		}
+	}
+	return nil
+}
+
+// checkContinuity returns an error if the entries of table are out of order
+// or TAI-UTC changes by other than one second at any of them
+func checkContinuity(table []leap) error {
+	for i := 1; i < len(table); i++ {
+		prev, l := table[i-1], table[i]
+		if l.UnixUTC <= prev.UnixUTC {
+			return fmt.Errorf("entry %d, %+v, is out of order", i+1, LeapSecond(l))
+		}
+		if d := l.CumulativeSkew - prev.CumulativeSkew; d != 1 && d != -1 {
+			return fmt.Errorf("entry %d, %+v, does not continue from %+v", i+1, LeapSecond(l), LeapSecond(prev))
+		}
+	}
+	return nil
+}
+
+// parseLeapSecondsList parses the leap-seconds.list format published by NIST
+// and the IERS, returning the table and its expiration as a UNIX time.  The
+// entries must be in order with TAI-UTC changing by one second at each, and
+// the hash (#h) is verified if present.  If needHash, a file without a hash is
+// rejected.
+func parseLeapSecondsList(r io.Reader, needHash bool) (table []LeapSecond, expires int64, err error) {
+	var (
+		updated, expiresNTP string
+		hash                []uint32
+		data                strings.Builder
+	)
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case strings.HasPrefix(line, "#$"):
+			updated = strings.TrimSpace(line[2:])
+		case strings.HasPrefix(line, "#@"):
+			expiresNTP = strings.TrimSpace(line[2:])
+		case strings.HasPrefix(line, "#h"):
+			// NIST omits leading zeros from the words of the hash, so they
+			// are compared numerically
+			for _, w := range strings.Fields(line[2:]) {
+				v, err := strconv.ParseUint(w, 16, 32)
+				if err != nil {
+					return nil, 0, fmt.Errorf("line %d: invalid hash", n)
+				}
+				hash = append(hash, uint32(v))
+			}
+		case line == "" || line[0] == '#':
+		default:
+			if i := strings.IndexByte(line, '#'); i >= 0 {
+				line = line[:i]
+			}
+			f := strings.Fields(line)
+			if len(f) != 2 {
+				return nil, 0, fmt.Errorf("line %d: expected a time and an offset", n)
+			}
+			ntp, err1 := strconv.ParseInt(f[0], 10, 64)
+			skew, err2 := strconv.ParseInt(f[1], 10, 64)
+			if err1 != nil || err2 != nil {
+				return nil, 0, fmt.Errorf("line %d: invalid entry", n)
+			}
+			l := LeapSecond{UnixUTC: ntp - ntpUnixSkew, CumulativeSkew: skew}
+			if k := len(table); k > 0 {
+				prev := table[k-1]
+				if l.UnixUTC <= prev.UnixUTC {
+					return nil, 0, fmt.Errorf("line %d: entries out of order", n)
+				}
+				if d := l.CumulativeSkew - prev.CumulativeSkew; d != 1 && d != -1 {
+					return nil, 0, fmt.Errorf("line %d: offset changes by %d seconds", n, d)
+				}
+			}
+			table = append(table, l)
+			data.WriteString(f[0])
+			data.WriteString(f[1])
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, 0, err
+	}
+	if len(table) == 0 {
+		return nil, 0, errors.New("no leap seconds")
+	}
+	if expiresNTP != "" {
+		v, err := strconv.ParseInt(expiresNTP, 10, 64)
+		if err != nil {
+			return nil, 0, errors.New("invalid expiration time")
+		}
+		expires = v - ntpUnixSkew
+	}
+	if hash == nil && needHash {
+		return nil, 0, errors.New("no hash")
+	}
+	if hash != nil {
+		sum := sha1.Sum([]byte(updated + expiresNTP + data.String()))
+		if len(hash) != 5 {
+			return nil, 0, errors.New("invalid hash")
+		}
+		for i, w := range hash {
+			if binary.BigEndian.Uint32(sum[4*i:]) != w {
+				return nil, 0, errors.New("hash does not match the contents")
+			}
+		}
+	}
+	return table, expires, nil
+}
@@ -0,0 +1,233 @@
+package tai_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestEmbeddedLeapTable(t *testing.T) {
+	if os.Getenv(tai.LeapSecondsFileEnv) != "" {
+		t.Skip("leap table overridden by the environment")
+	}
+	src, err := tai.LeapTableSource()
+	if err != nil || src != "embedded" {
+		t.Fatalf("expected the embedded table, got %q, %v", src, err)
+	}
+	table := tai.LeapSeconds()
+	if exp := (tai.LeapSecond{UnixUTC: 1483228800, CumulativeSkew: 37}); table[len(table)-1] != exp {
+		t.Fatalf("expected the last leap second to be %+v, got %+v", exp, table[len(table)-1])
+	}
+	if exp := (tai.LeapSecond{UnixUTC: 63072000, CumulativeSkew: 10}); table[0] != exp {
+		t.Fatalf("expected the first entry to be %+v, got %+v", exp, table[0])
+	}
+	// the expiry of the file as published, whose hash is verified at init
+	if exp := time.Date(2026, time.June, 28, 0, 0, 0, 0, time.UTC); !tai.LeapTableExpiry().AsTime().Equal(exp) {
+		t.Fatalf("expected the table to expire at %v, got %v", exp, tai.LeapTableExpiry().AsTime())
+	}
+}
+
+func TestLeapTableAtMidnightUTC(t *testing.T) {
+	for _, l := range tai.LeapSeconds() {
+		u := time.Unix(l.UnixUTC, 0).UTC()
+		if u.Day() != 1 || (u.Month() != time.January && u.Month() != time.July) || u.Hour() != 0 || u.Minute() != 0 || u.Second() != 0 {
+			t.Errorf("expected %+v to be at midnight UTC of January 1 or July 1, it is %v", l, u)
+		}
+	}
+}
+
+func TestLeapSecondElapsed(t *testing.T) {
+	cases := []struct {
+		descr  string
+		before time.Time
+		after  time.Time
+		exp    int64
+	}{
+		{"2016", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 2},
+		{"1972", time.Date(1972, 6, 30, 23, 59, 59, 0, time.UTC), time.Date(1972, 7, 1, 0, 0, 0, 0, time.UTC), 2},
+		{"NoLeap", time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2016, 12, 31, 0, 0, 1, 0, time.UTC), 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			a, _ := tai.FromTime(tc.before).Parts()
+			b, _ := tai.FromTime(tc.after).Parts()
+			if b-a != tc.exp {
+				t.Fatalf("expected %d elapsed seconds, got %d", tc.exp, b-a)
+			}
+		})
+	}
+	// 1972 began with TAI-UTC of 10 seconds
+	utc := time.Date(1972, 3, 1, 0, 0, 0, 0, time.UTC)
+	sec, _ := tai.FromTime(utc).Parts()
+	exp, _ := tai.Date(1972, 3, 1).AddHMS(0, 0, 10).Parts()
+	if sec != exp {
+		t.Fatalf("expected TAI-UTC of 10s in early 1972, got %ds", sec-exp+10)
+	}
+	if got := tai.FromTime(utc).AsTime(); !got.Equal(utc) {
+		t.Fatalf("expected %v, got %v", utc, got)
+	}
+}
+
+const overrideHelperEnv = "TAI_TEST_LEAP_OVERRIDE_HELPER"
+
+// TestLeapSecondsFileOverrideHelper runs in a subprocess started by
+// TestLeapSecondsFileOverride, and reports the table loaded at init
+func TestLeapSecondsFileOverrideHelper(t *testing.T) {
+	if os.Getenv(overrideHelperEnv) == "" {
+		t.Skip("helper process")
+	}
+	src, err := tai.LeapTableSource()
+	table := tai.LeapSeconds()
+	os.Stdout.WriteString(src + "|" + errString(err) + "|" + time.Unix(table[len(table)-1].UnixUTC, 0).UTC().Format("2006-01-02") + "\n")
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func TestLeapSecondsFileOverride(t *testing.T) {
+	if runtime.GOOS == "js" {
+		t.Skip("subprocesses are not supported on js")
+	}
+	dir, err := ioutil.TempDir("", "tai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	embedded, err := ioutil.ReadFile("leap-seconds.list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// without its hash, and with a hypothetical leap second at the end of 2030
+	var lines []string
+	for _, l := range strings.Split(string(embedded), "\n") {
+		if !strings.HasPrefix(l, "#h") {
+			lines = append(lines, l)
+		}
+	}
+	stripped := strings.Join(lines, "\n")
+	newer := stripped + "4133980800\t38\t# 1 Jan 2031\n"
+	cases := []struct {
+		descr    string
+		contents string
+		exp      string
+	}{
+		{"Newer", newer, "|2031-01-01"},
+		{"Tampered", strings.Replace(string(embedded), "3692217600", "3692217601", 1), "hash does not match"},
+		// the last entry commented out
+		{"Older", strings.Replace(stripped, "3692217600", "#", 1), "older than the built-in table"},
+		{"Garbage", "not a leap second file\n", "expected a time and an offset"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			path := filepath.Join(dir, tc.descr)
+			if err := ioutil.WriteFile(path, []byte(tc.contents), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			cmd := exec.Command(os.Args[0], "-test.run=^TestLeapSecondsFileOverrideHelper$")
+			cmd.Env = append(os.Environ(), overrideHelperEnv+"=1", tai.LeapSecondsFileEnv+"="+path)
+			out, err := cmd.Output()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(out), tc.exp) {
+				t.Fatalf("expected %q in the helper's output, got %s", tc.exp, out)
+			}
+			if tc.descr != "Newer" && !strings.Contains(string(out), "|2017-01-01") {
+				t.Fatalf("expected the embedded table to remain in use, got %s", out)
+			}
+		})
+	}
+}
+
+func TestLoadLeapSeconds(t *testing.T) {
+	embedded, err := ioutil.ReadFile("leap-seconds.list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const (
+		y2031 = 1924992000 // 2031-01-01
+		y2100 = 4102444800 // 2100-01-01
+	)
+	before := tai.LeapSeconds()
+	if err := tai.RegisterLeapSecond(y2100, 39); err != nil {
+		t.Fatal(err)
+	}
+	defer tai.RemoveLeapSecond(y2100)
+	var lines []string
+	for _, l := range strings.Split(string(embedded), "\n") {
+		if !strings.HasPrefix(l, "#h") {
+			lines = append(lines, l)
+		}
+	}
+	newer := strings.Join(lines, "\n") + "4133980800\t38\t# 1 Jan 2031\n"
+	if err := tai.LoadLeapSeconds(strings.NewReader(newer)); err != nil {
+		t.Fatal(err)
+	}
+	defer tai.RemoveLeapSecond(y2031)
+	table := tai.LeapSeconds()
+	if n := len(table); n != len(before)+2 {
+		t.Fatalf("expected %d entries, got %d", len(before)+2, n)
+	}
+	if l := table[len(table)-2]; l != (tai.LeapSecond{UnixUTC: y2031, CumulativeSkew: 38}) {
+		t.Errorf("expected the file's leap second in 2031, got %+v", l)
+	}
+	if l := table[len(table)-1]; l.UnixUTC != y2100 {
+		t.Errorf("expected the registered leap second to be kept, got %+v", l)
+	}
+
+	tampered := strings.Replace(string(embedded), "3692217600", "3692217601", 1)
+	if err := tai.LoadLeapSeconds(strings.NewReader(tampered)); err == nil || !strings.Contains(err.Error(), "hash does not match") {
+		t.Fatalf("expected a hash mismatch, got %v", err)
+	}
+	if n := len(tai.LeapSeconds()); n != len(table) {
+		t.Fatalf("expected the table to be unchanged by an invalid file, got %d entries", n)
+	}
+	if err := tai.LoadLeapSecondsFile("leap-seconds.list"); err != nil {
+		t.Fatal(err)
+	}
+	if got := tai.LeapSeconds(); len(got) != len(table) {
+		t.Fatalf("expected the older file to keep the later entries, got %d entries", len(got))
+	}
+	if err := tai.LoadLeapSecondsFile(filepath.Join("testdata", "missing")); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
+
+func TestLoadLeapSecondsDiscontinuous(t *testing.T) {
+	embedded, err := ioutil.ReadFile("leap-seconds.list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const y2100 = 4102444800 // 2100-01-01
+	if err := tai.RegisterLeapSecond(y2100, 38); err != nil {
+		t.Fatal(err)
+	}
+	defer tai.RemoveLeapSecond(y2100)
+	before := tai.LeapSeconds()
+	var lines []string
+	for _, l := range strings.Split(string(embedded), "\n") {
+		if !strings.HasPrefix(l, "#h") {
+			lines = append(lines, l)
+		}
+	}
+	// the file's leap second in 2031 leaves the registered one in 2100
+	// changing TAI-UTC by zero seconds
+	newer := strings.Join(lines, "\n") + "4133980800\t38\t# 1 Jan 2031\n"
+	if err := tai.LoadLeapSeconds(strings.NewReader(newer)); err == nil || !strings.Contains(err.Error(), "does not continue") {
+		t.Fatalf("expected a discontinuous table to be rejected, got %v", err)
+	}
+	if got := tai.LeapSeconds(); len(got) != len(before) || got[len(got)-1] != before[len(before)-1] {
+		t.Fatalf("expected the table to be unchanged, got %+v", got[len(got)-1])
+	}
+}
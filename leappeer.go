@@ -0,0 +1,67 @@
+package tai
+
+import "sync"
+
+// LeapTableHeader is the conventional key of the header or metadata entry in
+// which a process advertises its LeapTableHash to peers, e.g. as an HTTP
+// header or gRPC metadata on requests it already makes
+const LeapTableHeader = "Tai-Leap-Table"
+
+// LeapTableMismatch describes a peer whose leap second table differs from the
+// table of this process
+type LeapTableMismatch struct {
+	// Peer identifies the peer, as given to LeapTableChecker.Check
+	Peer string
+	// Local and Remote are the hashes of the tables of this process and the
+	// peer; see func LeapTableHash
+	Local, Remote string
+	// PeerStale is true if the peer's table is a predecessor of the local
+	// table, lacking one or more of its most recent leap seconds.  Otherwise
+	// the local table may be the stale one, or the tables may have diverged.
+	PeerStale bool
+}
+
+// LeapTableChecker compares the leap second tables advertised by peers to the
+// table of this process, to catch a cluster whose members disagree on TAI-UTC.
+//
+// The zero value of LeapTableChecker is ready to use and does nothing on a
+// mismatch beyond returning false from Check.  LeapTableChecker is safe for
+// concurrent use.
+type LeapTableChecker struct {
+	// OnMismatch, if not nil, is called when a peer advertises a table that
+	// differs from the local one.  It is called once for each distinct table
+	// advertised by a peer, not on every Check.
+	OnMismatch func(LeapTableMismatch)
+
+	mu       sync.Mutex
+	reported map[string]string
+}
+
+// Check compares the hash of a peer's table, such as the value of its
+// LeapTableHeader, to the current local table, and returns true if they
+// match.  An empty hash is treated as the peer not advertising its table and
+// is ignored.
+func (c *LeapTableChecker) Check(peer, remoteHash string) bool {
+	if remoteHash == "" {
+		return true
+	}
+	local := LeapTableHash()
+	if remoteHash == local {
+		c.mu.Lock()
+		delete(c.reported, peer)
+		c.mu.Unlock()
+		return true
+	}
+	c.mu.Lock()
+	if c.reported == nil {
+		c.reported = make(map[string]string)
+	}
+	seen := c.reported[peer] == remoteHash
+	c.reported[peer] = remoteHash
+	c.mu.Unlock()
+	if !seen && c.OnMismatch != nil {
+		_, stale := FindLeapTable(remoteHash)
+		c.OnMismatch(LeapTableMismatch{Peer: peer, Local: local, Remote: remoteHash, PeerStale: stale})
+	}
+	return false
+}
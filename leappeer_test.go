@@ -0,0 +1,42 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestLeapTableChecker(t *testing.T) {
+	var got []tai.LeapTableMismatch
+	c := tai.LeapTableChecker{OnMismatch: func(m tai.LeapTableMismatch) { got = append(got, m) }}
+	table := tai.LeapSeconds()
+	stale := tai.HashLeapSeconds(table[:len(table)-1])
+	if !c.Check("a", tai.LeapTableHash()) {
+		t.Error("expected the local table to match")
+	}
+	if !c.Check("a", "") {
+		t.Error("expected a peer that does not advertise its table to be ignored")
+	}
+	if c.Check("a", stale) {
+		t.Error("expected a stale table not to match")
+	}
+	c.Check("a", stale)
+	if c.Check("b", "f00d") {
+		t.Error("expected an unknown table not to match")
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 mismatches, got %d: %+v", len(got), got)
+	}
+	if exp := (tai.LeapTableMismatch{Peer: "a", Local: tai.LeapTableHash(), Remote: stale, PeerStale: true}); got[0] != exp {
+		t.Errorf("expected %+v, got %+v", exp, got[0])
+	}
+	if got[1].Peer != "b" || got[1].PeerStale {
+		t.Errorf("expected peer b with a table of unknown relation, got %+v", got[1])
+	}
+	// a peer that recovers and regresses is reported again
+	c.Check("a", tai.LeapTableHash())
+	c.Check("a", stale)
+	if len(got) != 3 {
+		t.Errorf("expected a regression to be reported, got %d mismatches", len(got))
+	}
+}
@@ -0,0 +1,117 @@
+package tai
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// LeapSecond is an entry of the leap second table: from the UNIX time UnixUTC
+// onward, TAI is CumulativeSkew seconds ahead of UTC
+type LeapSecond struct {
+	UnixUTC        int64
+	CumulativeSkew int64
+}
+
+// LeapSeconds returns a copy of the current leap second table in
+// chronological order
+func LeapSeconds() []LeapSecond {
+	leaplock.RLock()
+	defer leaplock.RUnlock()
+	out := make([]LeapSecond, len(leaps))
+	for i, l := range leaps {
+		out[i] = LeapSecond(l)
+	}
+	return out
+}
+
+// LeapStep returns the change of TAI-UTC at entry i of table: 1 for a
+// positive leap second, which inserts 23:59:60 at the end of the previous day,
+// and -1 for a negative leap second, which omits 23:59:59.  The first entry,
+// the initial offset of 1972, has a step of zero.
+func LeapStep(table []LeapSecond, i int) int64 {
+	if i == 0 {
+		return 0
+	}
+	return table[i].CumulativeSkew - table[i-1].CumulativeSkew
+}
+
+// LeapSecondAt returns the step (see func LeapStep) of the leap second of the
+// current table at the UNIX time unixUTC, and false if there is none
+func LeapSecondAt(unixUTC int64) (step int64, ok bool) {
+	leaplock.RLock()
+	defer leaplock.RUnlock()
+	for i := len(leaps) - 1; i >= 1; i-- {
+		if leaps[i].UnixUTC == unixUTC {
+			return leaps[i].CumulativeSkew - leaps[i-1].CumulativeSkew, true
+		}
+	}
+	return 0, false
+}
+
+// HashLeapSeconds returns an identifier of a leap second table, the
+// hex-encoded SHA-256 of its entries
+func HashLeapSeconds(table []LeapSecond) string {
+	h := sha256.New()
+	var buf [16]byte
+	for _, l := range table {
+		binary.BigEndian.PutUint64(buf[:8], uint64(l.UnixUTC))
+		binary.BigEndian.PutUint64(buf[8:], uint64(l.CumulativeSkew))
+		h.Write(buf[:])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LeapTableHash returns the identifier of the current leap second table; see
+// func HashLeapSeconds
+func LeapTableHash() string {
+	return HashLeapSeconds(LeapSeconds())
+}
+
+// FindLeapTable returns the table with the given hash from among the current
+// leap second table and each of its predecessors, i.e. the tables of stale
+// deployments that lack one or more of the most recent leap seconds.
+func FindLeapTable(hash string) ([]LeapSecond, bool) {
+	table := LeapSeconds()
+	for n := len(table); n > 0; n-- {
+		if HashLeapSeconds(table[:n]) == hash {
+			return table[:n], true
+		}
+	}
+	return nil, false
+}
+
+// Restamp corrects a TAI time that was converted from UTC using the old leap
+// second table, returning the TAI time the current table would have produced
+// from the same UTC time.
+//
+// this repairs data recorded by deployments with a stale table; see
+// FindLeapTable to recover the old table from its hash.
+func Restamp(t TAI, old []LeapSecond) TAI {
+	secs := t.sec - unixEpochSkew
+	// invert the conversion with the old table, then redo it with the current
+	utc := secs - unskewUnixIn(old, secs)
+	t.sec += skewUnix(utc, UTCToTAI) - skewUnixIn(old, utc)
+	return t
+}
+
+// skewUnixIn is skewUnix for an arbitrary table
+func skewUnixIn(table []LeapSecond, s int64) int64 {
+	for i := len(table) - 1; i >= 0; i-- {
+		if s >= table[i].UnixUTC {
+			return table[i].CumulativeSkew
+		}
+	}
+	return 0
+}
+
+// unskewUnixIn returns the skew that was added to a UNIX time to produce s,
+// the number of TAI seconds since the UNIX epoch, using table
+func unskewUnixIn(table []LeapSecond, s int64) int64 {
+	for i := len(table) - 1; i >= 0; i-- {
+		if s-table[i].CumulativeSkew >= table[i].UnixUTC {
+			return table[i].CumulativeSkew
+		}
+	}
+	return 0
+}
@@ -0,0 +1,40 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestFindLeapTable(t *testing.T) {
+	table := tai.LeapSeconds()
+	stale := table[:len(table)-1]
+	got, ok := tai.FindLeapTable(tai.HashLeapSeconds(stale))
+	if !ok || len(got) != len(stale) {
+		t.Fatalf("expected to find the stale table of %d entries, got %d", len(stale), len(got))
+	}
+	if got, ok := tai.FindLeapTable(tai.LeapTableHash()); !ok || len(got) != len(table) {
+		t.Fatal("did not find the current table by its hash")
+	}
+	if _, ok := tai.FindLeapTable("not a hash"); ok {
+		t.Fatal("found a table for an unknown hash")
+	}
+}
+
+func TestRestamp(t *testing.T) {
+	table := tai.LeapSeconds()
+	// a deployment that missed the leap second at the end of 2016
+	stale := table[:len(table)-1]
+	before := tai.Unix(1451606400, 0) // 2016-01-01, both tables agree
+	after := tai.Unix(1577836800, 0)  // 2020-01-01
+	staleAfter := after.Add(-1, 0)
+	if got := tai.Restamp(before, stale); !got.Eq(before) {
+		t.Fatalf("restamping before the missing leap changed the time: %+v", got)
+	}
+	if got := tai.Restamp(staleAfter, stale); !got.Eq(after) {
+		t.Fatalf("expected %+v, got %+v", after, got)
+	}
+	if got := tai.Restamp(after, table); !got.Eq(after) {
+		t.Fatalf("restamping with the current table changed the time: %+v", got)
+	}
+}
@@ -0,0 +1,58 @@
+package tai
+
+// Locale holds the names used by Format and Parse for the %a, %A, %b, %B, %o,
+// and %E specifiers
+type Locale struct {
+	// Months are the names of the months, January first
+	Months [12]string
+	// MonthsAbbrev are the abbreviated names of the months, for %b
+	MonthsAbbrev [12]string
+	// Weekdays are the names of the days of the week, Sunday first
+	Weekdays [7]string
+	// WeekdaysAbbrev are the abbreviated names of the days, for %a
+	WeekdaysAbbrev [7]string
+	// Ordinals are the suffixes of the days of the month for %o, that of the
+	// 1st first.  They may be empty.
+	Ordinals [31]string
+	// Eras are the names of the eras before and after year 1 for %EC, e.g.
+	// BCE and CE
+	Eras [2]string
+}
+
+// English is the default Locale
+var English = englishLocale()
+
+func englishLocale() Locale {
+	l := Locale{WeekdaysAbbrev: weekdayNamesAbbrev, Weekdays: weekdayNames, Eras: [2]string{"BCE", "CE"}}
+	for i := range l.Ordinals {
+		switch d := i + 1; {
+		case d/10 == 1:
+			l.Ordinals[i] = "th"
+		case d%10 == 1:
+			l.Ordinals[i] = "st"
+		case d%10 == 2:
+			l.Ordinals[i] = "nd"
+		case d%10 == 3:
+			l.Ordinals[i] = "rd"
+		default:
+			l.Ordinals[i] = "th"
+		}
+	}
+	copy(l.Months[:], monthNamesFull[1:])
+	copy(l.MonthsAbbrev[:], monthNamesAbbrev[1:])
+	return l
+}
+
+// month returns the full or abbreviated name of m, and the English
+// placeholder if m is not a valid month
+func (l *Locale) month(m Month, abbrev bool) string {
+	switch {
+	case !m.Valid() && abbrev:
+		return monthNamesAbbrev[0]
+	case !m.Valid():
+		return monthNamesFull[0]
+	case abbrev:
+		return l.MonthsAbbrev[m-1]
+	}
+	return l.Months[m-1]
+}
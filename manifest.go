@@ -0,0 +1,119 @@
+package tai
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime/debug"
+)
+
+// modulePath is the import path of pkg tai, used to find its version in the
+// build information of the binary
+const modulePath = "github.com/brandondube/tai"
+
+// Manifest records how the timestamps of a dataset were derived: the version
+// of pkg tai, the leap second table, and the conversion policies.  Stored
+// beside a dataset as JSON (see WriteManifest), it allows the conversions to
+// be repeated or audited later with func ReadManifest, even after the leap
+// second table or configuration has changed.
+type Manifest struct {
+	// Package is the import path of pkg tai
+	Package string `json:"package"`
+	// Version is the module version of pkg tai, or "(devel)" if unknown
+	Version string `json:"version"`
+	// LeapTableHash is the identifier of LeapSeconds; see func HashLeapSeconds
+	LeapTableHash string `json:"leapTableHash"`
+	// LeapSeconds is the leap second table.  It may be omitted, in which case
+	// the table is found by LeapTableHash; see func FindLeapTable
+	LeapSeconds []LeapSecond `json:"leapSeconds,omitempty"`
+	// Strict is Config.Strict
+	Strict bool `json:"strict"`
+	// Smear is Config.Smear
+	Smear Smear `json:"smear"`
+	// Layout is Config.Layout
+	Layout string `json:"layout"`
+	// Locale is Config.Locale
+	Locale Locale `json:"locale"`
+}
+
+// Manifest returns the manifest of the table and configuration of c
+func (c *Converter) Manifest() Manifest {
+	table := c.LeapSeconds()
+	return Manifest{
+		Package:       modulePath,
+		Version:       moduleVersion(),
+		LeapTableHash: HashLeapSeconds(table),
+		LeapSeconds:   table,
+		Strict:        c.config.Strict,
+		Smear:         c.config.Smear,
+		Layout:        c.config.Layout,
+		Locale:        c.config.Locale,
+	}
+}
+
+// Snapshot returns a Converter with the table and configuration of m.  An
+// error is returned if the table does not match LeapTableHash, or if it is
+// omitted and no such table is known.
+func (m Manifest) Snapshot() (*Converter, error) {
+	table := m.LeapSeconds
+	if len(table) == 0 {
+		var ok bool
+		table, ok = FindLeapTable(m.LeapTableHash)
+		if !ok {
+			return nil, fmt.Errorf("Manifest.Snapshot: unknown leap table %s", m.LeapTableHash)
+		}
+	} else if h := HashLeapSeconds(table); h != m.LeapTableHash {
+		return nil, fmt.Errorf("Manifest.Snapshot: leap table hash is %s, expected %s", h, m.LeapTableHash)
+	}
+	cfg := Config{Strict: m.Strict, Smear: m.Smear, Layout: m.Layout, Locale: m.Locale}
+	c, err := NewConverter(table, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Manifest.Snapshot: %w", err)
+	}
+	return c, nil
+}
+
+// WriteManifest writes the manifest of c to w as indented JSON
+func WriteManifest(w io.Writer, c *Converter) error {
+	b, err := json.MarshalIndent(c.Manifest(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("WriteManifest: %w", err)
+	}
+	b = append(b, '\n')
+	_, err = w.Write(b)
+	return err
+}
+
+// ReadManifest reads a manifest written by WriteManifest and returns a
+// Converter that reproduces the conversions of the one it was written from
+func ReadManifest(r io.Reader) (*Converter, error) {
+	var m Manifest
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("ReadManifest: %w", err)
+	}
+	if m.Package != modulePath {
+		return nil, fmt.Errorf("ReadManifest: manifest is for package %q, not %s", m.Package, modulePath)
+	}
+	return m.Snapshot()
+}
+
+// moduleVersion returns the version of pkg tai from the build information of
+// the binary
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
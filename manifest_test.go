@@ -0,0 +1,91 @@
+package tai_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestManifestRoundTrip(t *testing.T) {
+	defer restoreConfig(t)
+	if err := tai.Configure(tai.WithSmear(tai.SmearLinear), tai.WithLayout(tai.RFC3339Milli)); err != nil {
+		t.Fatal(err)
+	}
+	orig := tai.Snapshot()
+	restoreConfig(t)
+
+	var buf bytes.Buffer
+	if err := tai.WriteManifest(&buf, orig); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"smear": "linear"`) {
+		t.Fatalf("expected the smear policy by name, got %s", buf.String())
+	}
+	c, err := tai.ReadManifest(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := c.Config()
+	if cfg.Smear != tai.SmearLinear || cfg.Layout != tai.RFC3339Milli || cfg.Locale != tai.English {
+		t.Fatalf("unexpected configuration %+v", cfg)
+	}
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	if a, b := orig.FromUnix(leap, 0), c.FromUnix(leap, 0); !a.Eq(b) {
+		t.Fatalf("expected %+v, got %+v", a, b)
+	}
+}
+
+func TestManifestSnapshotFindsTable(t *testing.T) {
+	m := tai.Snapshot().Manifest()
+	table := m.LeapSeconds
+	m.LeapSeconds = nil
+	m.LeapTableHash = tai.HashLeapSeconds(table[:len(table)-1])
+	c, err := m.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(c.LeapSeconds()); n != len(table)-1 {
+		t.Fatalf("expected the stale table of %d entries, got %d", len(table)-1, n)
+	}
+}
+
+func TestManifestInvalid(t *testing.T) {
+	valid := tai.Snapshot().Manifest()
+	cases := []struct {
+		descr  string
+		modify func(*tai.Manifest)
+		ok     bool
+	}{
+		{"HashMismatch", func(m *tai.Manifest) { m.LeapSeconds = m.LeapSeconds[1:] }, false},
+		{"UnknownHash", func(m *tai.Manifest) { m.LeapSeconds, m.LeapTableHash = nil, "unknown" }, false},
+		// an empty layout takes the default, as in NewConverter
+		{"EmptyLayout", func(m *tai.Manifest) { m.Layout = "" }, true},
+		{"Unordered", func(m *tai.Manifest) {
+			m.LeapSeconds[1], m.LeapSeconds[2] = m.LeapSeconds[2], m.LeapSeconds[1]
+			m.LeapTableHash = tai.HashLeapSeconds(m.LeapSeconds)
+		}, false},
+		{"Package", func(m *tai.Manifest) { m.Package = "example.com/tai" }, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			m := valid
+			m.LeapSeconds = append([]tai.LeapSecond(nil), valid.LeapSeconds...)
+			tc.modify(&m)
+			b, err := json.Marshal(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := tai.ReadManifest(bytes.NewReader(b))
+			if tc.ok != (err == nil) {
+				t.Fatalf("expected ok %v, got %v", tc.ok, err)
+			}
+			if err == nil && c.Config().Layout != tai.RFC3339Nano {
+				t.Fatalf("expected the default layout, got %q", c.Config().Layout)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package tai
+
+import "errors"
+
+// ErrNotMonotonic is returned by a Monotonicizer with the Reject policy when
+// a stamp is not after the previous stamp
+var ErrNotMonotonic = errors.New("tai: timestamp is not after the previous timestamp")
+
+// MonotonicPolicy is how a Monotonicizer handles a stamp that is at or before
+// the previous stamp
+type MonotonicPolicy int
+
+const (
+	// Reject discards the stamp and returns ErrNotMonotonic
+	Reject MonotonicPolicy = iota
+	// Clamp replaces the stamp with the previous stamp plus MinStep
+	Clamp
+	// Interpolate withholds the stamp until the next in-order stamp arrives,
+	// then spaces the withheld stamps evenly between the two in-order stamps.
+	// If they are too close to space the withheld stamps an attosecond apart,
+	// the withheld stamps are clamped instead, and the next stamp after them.
+	Interpolate
+)
+
+// Monotonicizer enforces that a stream of timestamps is strictly increasing,
+// such as those emitted by hardware that occasionally repeats or regresses.
+//
+// The zero value of Monotonicizer uses the Reject policy.  Monotonicizer is not
+// safe for concurrent use.
+type Monotonicizer struct {
+	// Policy is how regressions are handled
+	Policy MonotonicPolicy
+	// MinStep is the spacing of clamped stamps; zero is treated as one
+	// attosecond
+	MinStep Duration
+	// Log, if not nil, records every stamp that is clamped or interpolated
+	Log *AdjustmentLog
+
+	last    TAI
+	started bool
+	// withheld are the stamps awaiting interpolation
+	withheld []TAI
+}
+
+// Push submits the next stamp of the stream and returns the stamps that are
+// ready for output, in order.  Under the Interpolate policy, a regressing stamp
+// produces no output until the stream recovers or Flush is called.
+func (m *Monotonicizer) Push(t TAI) ([]TAI, error) {
+	if !m.started {
+		m.started = true
+		m.last = t
+		return []TAI{t}, nil
+	}
+	if t.After(m.last) {
+		out := make([]TAI, 0, len(m.withheld)+1)
+		if len(m.withheld) > 0 {
+			step := sub(t, m.last).div(int64(len(m.withheld) + 1))
+			if step == (Duration{}) {
+				out = append(out, m.Flush()...)
+				if !t.After(m.last) {
+					return append(out, m.clamp(t)), nil
+				}
+				m.last = t
+				return append(out, t), nil
+			}
+			for i, w := range m.withheld {
+				s := m.last.AddDuration(step.Mul(int64(i + 1)))
+				m.Log.record(Adjustment{Kind: Interpolated, At: s, Phase: sub(s, w)})
+				out = append(out, s)
+			}
+			m.withheld = m.withheld[:0]
+		}
+		m.last = t
+		return append(out, t), nil
+	}
+	switch m.Policy {
+	case Clamp:
+		return []TAI{m.clamp(t)}, nil
+	case Interpolate:
+		m.withheld = append(m.withheld, t)
+		return nil, nil
+	default:
+		return nil, ErrNotMonotonic
+	}
+}
+
+// Flush returns any stamps withheld by the Interpolate policy, clamped to the
+// previous stamp.  Call Flush at the end of a stream.
+func (m *Monotonicizer) Flush() []TAI {
+	var out []TAI
+	for _, w := range m.withheld {
+		out = append(out, m.clamp(w))
+	}
+	m.withheld = m.withheld[:0]
+	return out
+}
+
+// Last returns the most recently output stamp, and false if there is none
+func (m *Monotonicizer) Last() (TAI, bool) {
+	return m.last, m.started
+}
+
+// clamp outputs the stamp t as the previous stamp plus MinStep
+func (m *Monotonicizer) clamp(t TAI) TAI {
+	m.last = m.last.AddDuration(m.minStep())
+	m.Log.record(Adjustment{Kind: Clamped, At: m.last, Phase: sub(m.last, t)})
+	return m.last
+}
+
+func (m *Monotonicizer) minStep() Duration {
+	if m.MinStep == (Duration{}) {
+		return Duration{asec: 1}
+	}
+	return m.MinStep
+}
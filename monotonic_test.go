@@ -0,0 +1,78 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func pushAll(t *testing.T, m *tai.Monotonicizer, ts []tai.TAI) []tai.TAI {
+	var out []tai.TAI
+	for _, ta := range ts {
+		o, err := m.Push(ta)
+		if err != nil && err != tai.ErrNotMonotonic {
+			t.Fatal(err)
+		}
+		out = append(out, o...)
+	}
+	return append(out, m.Flush()...)
+}
+
+func TestMonotonicizerPolicies(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	at := func(ms int64) tai.TAI { return base.Add(0, ms*tai.Millisecond) }
+	inp := []tai.TAI{at(0), at(10), at(10), at(5), at(40), at(30)}
+	cases := []struct {
+		descr  string
+		policy tai.MonotonicPolicy
+		exp    []tai.TAI
+	}{
+		{"Reject", tai.Reject, []tai.TAI{at(0), at(10), at(40)}},
+		{"Clamp", tai.Clamp, []tai.TAI{at(0), at(10), at(11), at(12), at(40), at(41)}},
+		{"Interpolate", tai.Interpolate, []tai.TAI{at(0), at(10), at(20), at(30), at(40), at(41)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			m := tai.Monotonicizer{Policy: tc.policy, MinStep: tai.Dur(0, tai.Millisecond)}
+			got := pushAll(t, &m, inp)
+			if len(got) != len(tc.exp) {
+				t.Fatalf("expected %d stamps, got %d", len(tc.exp), len(got))
+			}
+			for i := range got {
+				if !got[i].Eq(tc.exp[i]) {
+					t.Errorf("stamp %d: expected %+v, got %+v", i, tc.exp[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMonotonicizerRejectError(t *testing.T) {
+	var m tai.Monotonicizer
+	now := tai.Date(2024, 7, 1)
+	m.Push(now)
+	if _, err := m.Push(now); err != tai.ErrNotMonotonic {
+		t.Fatalf("expected ErrNotMonotonic for a repeated stamp, got %v", err)
+	}
+	if last, ok := m.Last(); !ok || !last.Eq(now) {
+		t.Fatalf("expected last stamp %+v, got %+v", now, last)
+	}
+}
+
+func TestMonotonicizerInterpolateNarrowGap(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	// two stamps withheld before a recovery of one attosecond, which is too
+	// narrow to space them within
+	inp := []tai.TAI{base, base, base.Add(0, -1), base.Add(0, 1), base.Add(0, 5)}
+	exp := []tai.TAI{base, base.Add(0, 1), base.Add(0, 2), base.Add(0, 3), base.Add(0, 5)}
+	m := tai.Monotonicizer{Policy: tai.Interpolate}
+	got := pushAll(t, &m, inp)
+	if len(got) != len(exp) {
+		t.Fatalf("expected %d stamps, got %d", len(exp), len(got))
+	}
+	for i := range got {
+		if !got[i].Eq(exp[i]) {
+			t.Errorf("stamp %d: expected %+v, got %+v", i, exp[i], got[i])
+		}
+	}
+}
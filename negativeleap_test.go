@@ -0,0 +1,113 @@
+package tai_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+// negativeLeap is the UNIX time of 2100-01-01, used for a hypothetical
+// negative leap second that omits 2099-12-31T23:59:59Z
+const negativeLeap = 4102444800
+
+// withNegativeLeap runs f with a negative leap second registered at
+// negativeLeap
+func withNegativeLeap(t *testing.T, f func()) {
+	t.Helper()
+	table := tai.LeapSeconds()
+	if err := tai.RegisterLeapSecond(negativeLeap, table[len(table)-1].CumulativeSkew-1); err != nil {
+		t.Fatal(err)
+	}
+	defer tai.RemoveLeapSecond(negativeLeap)
+	f()
+}
+
+func TestNegativeLeapStep(t *testing.T) {
+	withNegativeLeap(t, func() {
+		table := tai.LeapSeconds()
+		if s := tai.LeapStep(table, len(table)-1); s != -1 {
+			t.Fatalf("expected a step of -1, got %d", s)
+		}
+		if s := tai.LeapStep(table, len(table)-2); s != 1 {
+			t.Fatalf("expected a step of 1 for 2017, got %d", s)
+		}
+		if s := tai.LeapStep(table, 0); s != 0 {
+			t.Fatalf("expected a step of 0 for the initial offset, got %d", s)
+		}
+		if s, ok := tai.LeapSecondAt(negativeLeap); !ok || s != -1 {
+			t.Fatalf("expected a negative leap second, got %d, %v", s, ok)
+		}
+		if _, ok := tai.LeapSecondAt(negativeLeap - 1); ok {
+			t.Fatal("expected no leap second")
+		}
+	})
+}
+
+func TestNegativeLeapConversion(t *testing.T) {
+	withNegativeLeap(t, func() {
+		before, after := tai.Unix(negativeLeap-2, 0), tai.Unix(negativeLeap, 0)
+		if exp := before.Add(1, 0); !after.Eq(exp) {
+			t.Fatalf("expected one TAI second from 23:59:58 to 00:00:00, got %+v and %+v", before, after)
+		}
+		if skipped := tai.Unix(negativeLeap-1, 0); !skipped.Eq(after) {
+			t.Fatalf("expected the omitted second to be the start of the next day, got %+v", skipped)
+		}
+		if _, err := tai.UnixOpts(negativeLeap-1, 0, tai.ConvertOptions{Strict: true}); !errors.Is(err, tai.ErrSkippedSecond) {
+			t.Fatalf("expected ErrSkippedSecond, got %v", err)
+		}
+		// UTC read from each TAI second across the leap never shows 23:59:59
+		var got []int64
+		for i := int64(0); i < 4; i++ {
+			got = append(got, before.Add(0, 5e17).Add(i, 0).AsTime().Unix()-negativeLeap)
+		}
+		exp := []int64{-2, 0, 1, 2}
+		for i := range exp {
+			if got[i] != exp[i] {
+				t.Fatalf("expected %v, got %v", exp, got)
+			}
+		}
+	})
+}
+
+func TestNegativeLeapParse(t *testing.T) {
+	defer restoreConfig(t)
+	withNegativeLeap(t, func() {
+		const skipped = "2099-12-31T23:59:59Z"
+		got, err := tai.ParseTemporalInstant(skipped)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exp := tai.Unix(negativeLeap, 0); !got.Eq(exp) {
+			t.Fatalf("expected %+v, got %+v", exp, got)
+		}
+		if err := tai.Configure(tai.WithStrict(true)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tai.ParseTemporalInstant(skipped); err == nil {
+			t.Fatal("expected strict mode to reject the omitted second")
+		}
+		if _, err := tai.ParseTemporalInstant("2099-12-31T23:59:58Z"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNegativeLeapSmear(t *testing.T) {
+	withNegativeLeap(t, func() {
+		c, err := tai.NewConverter(tai.LeapSeconds(), tai.Config{Smear: tai.SmearLinear})
+		if err != nil {
+			t.Fatal(err)
+		}
+		start, end := c.FromUnix(negativeLeap-12*tai.Hour, 0), c.FromUnix(negativeLeap+12*tai.Hour, 0)
+		if exp := start.Add(tai.Day-1, 0); !end.Eq(exp) {
+			t.Fatalf("expected 86399 TAI seconds over the smear, got %+v to %+v", start, end)
+		}
+		// every UTC second exists in a smear, including 23:59:59
+		tm := time.Unix(negativeLeap-1, 0)
+		if got := c.AsTime(c.FromTime(tm)); !got.Equal(tm) {
+			t.Fatalf("expected %v, got %v", tm, got)
+		}
+	})
+}
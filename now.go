@@ -0,0 +1,59 @@
+package tai
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	nowPrecisionOnce sync.Once
+	nowPrecision     Duration
+)
+
+// NowPrecision returns the resolution of the host clock read by func Now,
+// the smallest step observed between successive readings.  It is measured
+// once, on first use.
+//
+// most hosts resolve nanoseconds or microseconds.  Under js/wasm, browsers
+// coarsen their clock to mitigate timing attacks, to 100 microseconds or
+// more unless the page is cross-origin isolated, and Now is only as precise.
+func NowPrecision() Duration {
+	nowPrecisionOnce.Do(func() {
+		nowPrecision = measurePrecision(hostNanos)
+	})
+	return nowPrecision
+}
+
+// measurePrecision returns the smallest nonzero step of a clock in
+// nanoseconds, from several readings
+func measurePrecision(clock func() int64) Duration {
+	const samples = 8
+	var best int64
+	for i := 0; i < samples; i++ {
+		start := clock()
+		next := start
+		for next == start {
+			next = clock()
+		}
+		if step := next - start; best == 0 || step < best {
+			best = step
+		}
+	}
+	return Dur(0, best*Nanosecond)
+}
+
+// NowPair returns the current moment as both a time.Time in UTC and a TAI,
+// from a single reading of the host clock converted with a single lookup of
+// the leap second table.  Unlike calling time.Now and Now in turn, the two
+// always represent the same moment: they can not straddle a leap second or an
+// update of the table, as may two separate readings.
+func NowPair() (time.Time, TAI) {
+	secs, nsecs := hostNow()
+	return time.Unix(secs, nsecs).UTC(), Unix(secs, nsecs)
+}
+
+// NowPair is func NowPair with the table and configuration of c
+func (c *Converter) NowPair() (time.Time, TAI) {
+	secs, nsecs := hostNow()
+	return time.Unix(secs, nsecs).UTC(), c.FromUnix(secs, nsecs)
+}
@@ -0,0 +1,27 @@
+//go:build js && wasm
+// +build js,wasm
+
+package tai
+
+import "syscall/js"
+
+// performance is the High Resolution Time API of the host.  The Go runtime
+// reads the wall clock with Date.now, whose resolution is one millisecond;
+// performance.timeOrigin + performance.now() is the same clock at the finest
+// resolution the host permits.
+var performance = js.Global().Get("performance")
+
+// hostNow returns the host's realtime clock as a UNIX time in seconds and
+// nanoseconds
+func hostNow() (secs, nsecs int64) {
+	return floorDiv(hostNanos(), 1e9)
+}
+
+// hostNanos returns the host's realtime clock as nanoseconds since the UNIX
+// epoch.  The origin and the offset from it are converted separately, so the
+// precision of the offset is not lost to the magnitude of the origin.
+func hostNanos() int64 {
+	origin := performance.Get("timeOrigin").Float()
+	elapsed := performance.Call("now").Float()
+	return int64(origin*1e6) + int64(elapsed*1e6)
+}
@@ -0,0 +1,19 @@
+//go:build !js
+// +build !js
+
+package tai
+
+import "time"
+
+// hostNow returns the host's realtime clock as a UNIX time in seconds and
+// nanoseconds
+func hostNow() (secs, nsecs int64) {
+	now := time.Now()
+	return now.Unix(), int64(now.Nanosecond())
+}
+
+// hostNanos returns the host's realtime clock as nanoseconds since the UNIX
+// epoch
+func hostNanos() int64 {
+	return time.Now().UnixNano()
+}
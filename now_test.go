@@ -0,0 +1,47 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestNowPrecision(t *testing.T) {
+	p := tai.NowPrecision()
+	if !tai.Dur(0, 0).Less(p) || tai.Dur(1, 0).Less(p) {
+		t.Fatalf("expected a precision between 0 and 1 s, got %v", p)
+	}
+	if again := tai.NowPrecision(); !again.Eq(p) {
+		t.Fatalf("expected the precision to be measured once, got %v then %v", p, again)
+	}
+}
+
+func TestNowAgreesWithFromTime(t *testing.T) {
+	// time.Now has a resolution of one millisecond under js/wasm, so the
+	// bounds are widened by as much
+	before := tai.FromTime(time.Now()).Add(0, -1e15)
+	now := tai.Now()
+	after := tai.FromTime(time.Now()).Add(0, 1e15)
+	if now.Before(before) || now.After(after) {
+		t.Fatalf("expected Now to be between %+v and %+v, got %+v", before, after, now)
+	}
+}
+
+func TestNowPair(t *testing.T) {
+	utc, ta := tai.NowPair()
+	if utc.Location() != time.UTC {
+		t.Errorf("expected a time in UTC, got %v", utc.Location())
+	}
+	if got := tai.FromTime(utc); !got.Eq(ta) {
+		t.Errorf("expected the pair to be the same moment, %v is %+v but got %+v", utc, got, ta)
+	}
+	c, err := tai.NewConverter([]tai.LeapSecond{{UnixUTC: 63072000, CumulativeSkew: 10}}, tai.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	utc, ta = c.NowPair()
+	if got := c.FromTime(utc); !got.Eq(ta) {
+		t.Errorf("expected the Converter's pair to be the same moment, %v is %+v but got %+v", utc, got, ta)
+	}
+}
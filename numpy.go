@@ -0,0 +1,105 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Datetime64Unit is the unit of a numpy datetime64 value
+type Datetime64Unit int
+
+const (
+	// Datetime64Nanosecond is numpy's "ns" unit, range ~= +/- 292 years
+	Datetime64Nanosecond Datetime64Unit = iota
+	// Datetime64Picosecond is numpy's "ps" unit, range ~= +/- 106 days
+	Datetime64Picosecond
+	// Datetime64Femtosecond is numpy's "fs" unit, range ~= +/- 2.6 hours
+	Datetime64Femtosecond
+	// Datetime64Attosecond is numpy's "as" unit, range ~= +/- 9.2 seconds
+	Datetime64Attosecond
+
+	// Datetime64NaT is the integer representation of numpy's Not a Time
+	Datetime64NaT = math.MinInt64
+)
+
+var (
+	// ErrNaT is returned when converting numpy's Not a Time to TAI
+	ErrNaT = errors.New("tai: datetime64 value is NaT")
+
+	datetime64Asecs = [...]int64{Nanosecond, Picosecond, Femtosecond, Attosecond}
+	datetime64Names = [...]string{"ns", "ps", "fs", "as"}
+)
+
+// String returns the numpy unit code, e.g. "ns"
+func (u Datetime64Unit) String() string {
+	if u < 0 || int(u) >= len(datetime64Names) {
+		return fmt.Sprintf("Datetime64Unit(%d)", int(u))
+	}
+	return datetime64Names[u]
+}
+
+func (u Datetime64Unit) asecs() (int64, error) {
+	if u < 0 || int(u) >= len(datetime64Asecs) {
+		return 0, fmt.Errorf("invalid datetime64 unit %d", int(u))
+	}
+	return datetime64Asecs[u], nil
+}
+
+// FromDatetime64 returns the TAI time corresponding to the integer
+// representation of a numpy datetime64 value in the given unit.
+//
+// numpy datetime64 values count units since the UNIX epoch, without leap
+// seconds.  The leap second table is consulted in making the conversion; see
+// func Unix.
+//
+// ErrNaT is returned if v is Datetime64NaT.
+func FromDatetime64(v int64, unit Datetime64Unit) (TAI, error) {
+	if v == Datetime64NaT {
+		return TAI{}, ErrNaT
+	}
+	per, err := unit.asecs()
+	if err != nil {
+		return TAI{}, fmt.Errorf("FromDatetime64: %w", err)
+	}
+	perSec := 1e18 / per
+	secs, rem := floorDiv(v, perSec)
+	return unixAsec(secs, rem*per), nil
+}
+
+// Datetime64 returns the integer representation of t as a numpy datetime64
+// value in the given unit.  Sub-unit time is truncated toward the past.
+//
+// an error is returned if t is not representable in the unit; the finer
+// units have very limited range about the UNIX epoch.
+func (t TAI) Datetime64(unit Datetime64Unit) (int64, error) {
+	per, err := unit.asecs()
+	if err != nil {
+		return 0, fmt.Errorf("Datetime64: %w", err)
+	}
+	perSec := 1e18 / per
+	secs, asec := t.unix()
+	v, ok := mulAdd(secs, perSec, asec/per)
+	if !ok || v == Datetime64NaT {
+		return 0, fmt.Errorf("Datetime64: %s overflows datetime64[%s]", t.Format(RFC3339Nano), unit)
+	}
+	return v, nil
+}
+
+// mulAdd returns a*b+c and false if the computation overflows int64; b is
+// positive and 0 <= c < b
+func mulAdd(a, b, c int64) (int64, bool) {
+	if a >= 0 {
+		if a > (math.MaxInt64-c)/b {
+			return 0, false
+		}
+		return a*b + c, true
+	}
+	// a*b may not be representable even when the sum is, so borrow from a;
+	// afterwards -b < c <= 0 and truncated division rounds toward +inf
+	a, c = a+1, c-b
+	if a < (math.MinInt64-c)/b {
+		return 0, false
+	}
+	return a*b + c, true
+}
@@ -0,0 +1,62 @@
+package tai_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestDatetime64RoundTrip(t *testing.T) {
+	cases := []struct {
+		descr string
+		inp   int64
+		unit  tai.Datetime64Unit
+	}{
+		{"NsRecent", 1630706636991894123, tai.Datetime64Nanosecond},
+		{"NsBeforeEpoch", -1, tai.Datetime64Nanosecond},
+		{"PsMax", math.MaxInt64, tai.Datetime64Picosecond},
+		{"FsNegative", -123456789012345678, tai.Datetime64Femtosecond},
+		{"AsMin", math.MinInt64 + 1, tai.Datetime64Attosecond},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			ta, err := tai.FromDatetime64(tc.inp, tc.unit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ta.Datetime64(tc.unit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.inp {
+				t.Fatalf("expected %d, got %d", tc.inp, got)
+			}
+		})
+	}
+}
+
+func TestDatetime64AgreesWithUnix(t *testing.T) {
+	ta, err := tai.FromDatetime64(1630706636991894123, tai.Datetime64Nanosecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ta.Eq(tai.Unix(1630706636, 991894123)) {
+		t.Fatalf("datetime64[ns] disagrees with Unix, got %+v", ta)
+	}
+}
+
+func TestDatetime64NaT(t *testing.T) {
+	_, err := tai.FromDatetime64(tai.Datetime64NaT, tai.Datetime64Nanosecond)
+	if !errors.Is(err, tai.ErrNaT) {
+		t.Fatalf("expected ErrNaT, got %v", err)
+	}
+}
+
+func TestDatetime64Overflow(t *testing.T) {
+	_, err := tai.Date(2024, 1, 1).Datetime64(tai.Datetime64Picosecond)
+	if err == nil {
+		t.Fatal("expected overflow error converting 2024 to datetime64[ps]")
+	}
+}
@@ -0,0 +1,39 @@
+package tai
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrOTelRange is returned when a TAI time is outside of the range of an
+// OpenTelemetry timestamp, the UNIX epoch through 2554-07-21
+var ErrOTelRange = errors.New("tai: time out of range of an OpenTelemetry timestamp")
+
+// FromOTelNanos returns the TAI time of an OpenTelemetry timestamp, such as a
+// span's start_time_unix_nano: nanoseconds since the UNIX epoch in the UTC
+// time system.  The leap second table is consulted in making the conversion;
+// see func Unix.
+//
+// OpenTelemetry uses zero for an unset timestamp; callers should check for it
+// before converting.
+func FromOTelNanos(ns uint64) TAI {
+	return unixAsec(int64(ns/1e9), int64(ns%1e9)*Nanosecond)
+}
+
+// OTelNanos returns t as an OpenTelemetry timestamp, truncated toward the past
+// to nanoseconds.  The leap second table is consulted in making the
+// conversion; see func (TAI) Unix.
+//
+// ErrOTelRange is returned for times before the UNIX epoch or after the last
+// representable nanosecond.
+func (t TAI) OTelNanos() (uint64, error) {
+	secs, asec := t.unix()
+	if secs < 0 || uint64(secs) > math.MaxUint64/uint64(1e9) {
+		return 0, ErrOTelRange
+	}
+	whole, ns := uint64(secs)*1e9, uint64(asec/Nanosecond)
+	if ns > math.MaxUint64-whole {
+		return 0, ErrOTelRange
+	}
+	return whole + ns, nil
+}
@@ -0,0 +1,42 @@
+package tai_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestOTelNanos(t *testing.T) {
+	const ns = 1719837296123456789
+	ta := tai.FromOTelNanos(ns)
+	if exp := tai.FromTime(time.Unix(0, ns)); !ta.Eq(exp) {
+		t.Fatalf("expected %v, got %v", exp.AsTime(), ta.AsTime())
+	}
+	got, err := ta.OTelNanos()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != ns {
+		t.Fatalf("expected %d, got %d", uint64(ns), got)
+	}
+}
+
+func TestOTelNanosRange(t *testing.T) {
+	// beyond the range of int64 nanoseconds, but not of OpenTelemetry's uint64
+	const far = math.MaxUint64 - 5
+	got, err := tai.FromOTelNanos(far).OTelNanos()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != far {
+		t.Fatalf("expected %d, got %d", uint64(far), got)
+	}
+	if _, err := tai.Date(1960, 1, 1).OTelNanos(); err != tai.ErrOTelRange {
+		t.Fatalf("expected ErrOTelRange before the UNIX epoch, got %v", err)
+	}
+	if _, err := tai.Date(2600, 1, 1).OTelNanos(); err != tai.ErrOTelRange {
+		t.Fatalf("expected ErrOTelRange after 2554, got %v", err)
+	}
+}
@@ -0,0 +1,318 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Parse parses s according to layout, which uses the same specifiers as func
+// Format, and returns the instant it represents in the TAI calendar.  It is
+// the inverse of Format; for all layouts and all t for which the formatted
+// representation retains every field, Parse(layout, t.Format(layout)) == t.
+//
+// fields absent from layout take their value from the TAI epoch, midnight
+// January 1, 1958.  %y is interpreted as 1969-2068, as by POSIX strptime.
+// %j sets the date if neither %m, %b, nor %B is present.  Weekdays (%a, %A,
+// %w) are checked against the date, and %U is skipped.  %Ey sets the year
+// within the era of %EC, CE if it is absent; %EC alone is checked against the
+// year.  Month and weekday names, ordinal suffixes, and eras are those of the
+// configured Locale, matched without regard to case; %b also accepts the first
+// three letters of a longer abbreviation, e.g. Sep for Sept.
+func Parse(layout, s string) (TAI, error) {
+	return parseLocale(layout, s, currentConfig().locale())
+}
+
+// parseLocale is Parse with the names of loc
+func parseLocale(layout, s string, loc *Locale) (TAI, error) {
+	p := parser{s: s}
+	return parseWith(&p, layout, loc, true)
+}
+
+// parseWith parses p.s according to layout.  If whole is false, p.s need only
+// begin with a match, and p.i is its length.
+func parseWith(p *parser, layout string, loc *Locale, whole bool) (TAI, error) {
+	var (
+		year, month, day  = 1958, 1, 1
+		hour, min, sec    int
+		asec              int64
+		yday, weekday, pm = 0, -1, -1
+		hasMonth, hasHour bool
+		hour12            = -1
+		era, yoe          = -1, 0
+	)
+	for i := 0; i < len(layout) && p.err == nil; i++ {
+		c := layout[i]
+		if c != '%' {
+			if (c == '.' || c == ',') && p.peek() != c && trimmedFraction(layout, i+1) {
+				// Format removes the separator of a fraction trimmed to nothing
+				_, _, i, _ = parseFracSpec(layout, i+2)
+				continue
+			}
+			p.expect(c)
+			continue
+		}
+		i++
+		if i == len(layout) {
+			break
+		}
+		spec := layout[i]
+		width, trim := 9, false
+		if spec == '-' || (spec >= '0' && spec <= '9') {
+			var ok bool
+			width, trim, i, ok = parseFracSpec(layout, i)
+			if !ok {
+				return TAI{}, errors.New("Parse: flags and widths are only valid for %N with 1 to 18 digits")
+			}
+			spec = 'N'
+		}
+		switch spec {
+		case '%':
+			p.expect('%')
+		case 'a':
+			weekday = p.name(loc.WeekdaysAbbrev[:])
+		case 'A':
+			weekday = p.name(loc.Weekdays[:])
+		case 'w':
+			weekday = p.digits(1)
+			if weekday > 6 {
+				p.fail("weekday out of range")
+			}
+		case 'd':
+			day = p.digits(2)
+		case 'e':
+			if p.peek() == ' ' {
+				p.next()
+				day = p.digits(1)
+			} else {
+				day = p.number(2)
+			}
+		case 'o':
+			day = p.number(2)
+			if day >= 1 && day <= 31 {
+				p.name(loc.Ordinals[day-1 : day])
+			}
+		case 'b':
+			month, hasMonth = p.monthAbbrev(loc.MonthsAbbrev[:])+1, true
+		case 'B':
+			month, hasMonth = p.name(loc.Months[:])+1, true
+		case 'm':
+			month, hasMonth = p.digits(2), true
+		case 'y':
+			year = p.digits(2)
+			if year < 69 {
+				year += 2000
+			} else {
+				year += 1900
+			}
+		case 'Y':
+			// a year directly followed by another number must be four digits
+			fixed := i+2 < len(layout) && layout[i+1] == '%' && strings.IndexByte("demoyYHIMSfFjUw", layout[i+2]) >= 0
+			year = p.year(fixed)
+		case 'E':
+			i++
+			if i == len(layout) {
+				return TAI{}, errors.New("Parse: %E must be followed by C, y, or Y")
+			}
+			switch layout[i] {
+			case 'C':
+				era = p.name(loc.Eras[:])
+			case 'y', 'Y':
+				if yoe = p.number(18); yoe < 1 {
+					p.fail("year of era out of range")
+				}
+				if layout[i] == 'Y' {
+					p.expect(' ')
+					era = p.name(loc.Eras[:])
+				}
+			default:
+				return TAI{}, fmt.Errorf("Parse: invalid format specifier %%E%c", layout[i])
+			}
+		case 'H':
+			hour, hasHour = p.digits(2), true
+		case 'I':
+			hour12 = p.digits(2)
+			if hour12 < 1 || hour12 > 12 {
+				p.fail("hour out of range")
+			}
+		case 'p':
+			pm = p.name([]string{"AM", "PM"})
+		case 'M':
+			min = p.digits(2)
+		case 'S':
+			sec = p.digits(2)
+		case 'f':
+			asec = int64(p.digits(6)) * Microsecond
+		case 'F':
+			asec = int64(p.digits(9)) * Nanosecond
+		case 'N':
+			if trim {
+				if c := p.peek(); c >= '0' && c <= '9' {
+					asec = p.fraction(width) * pow10[18-width]
+				}
+			} else {
+				asec = 0
+				for k := 0; k < width; k++ {
+					asec = asec*10 + int64(p.digits(1))
+				}
+				asec *= pow10[18-width]
+			}
+		case 'Z':
+			p.expect('Z')
+		case 'j':
+			yday = p.digits(3)
+		case 'U':
+			p.digits(2)
+		default:
+			return TAI{}, fmt.Errorf("Parse: invalid format specifier %%%c", spec)
+		}
+	}
+	if whole && p.err == nil && p.i != len(p.s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		if p.quiet {
+			return TAI{}, p.err
+		}
+		return TAI{}, fmt.Errorf("Parse: %w", p.err)
+	}
+	if yoe != 0 {
+		if era == 0 {
+			year = 1 - yoe
+		} else {
+			year = yoe
+		}
+	} else if era >= 0 {
+		if e, _ := eraOf(year); e != era {
+			return TAI{}, fmt.Errorf("Parse: era %s disagrees with the year %d", loc.Eras[era], year)
+		}
+	}
+	if hour12 >= 0 {
+		if hasHour && hour%12 != hour12%12 {
+			return TAI{}, fmt.Errorf("Parse: %%I %d disagrees with %%H %d", hour12, hour)
+		}
+		hour = hour12 % 12
+		if pm == 1 {
+			hour += 12
+		}
+	} else if pm >= 0 && !hasHour {
+		return TAI{}, fmt.Errorf("Parse: %%p requires %%I or %%H")
+	}
+	if sec > 59 {
+		// TAI has no leap seconds
+		return TAI{}, fmt.Errorf("Parse: second %d out of range", sec)
+	}
+	var days int64
+	if yday != 0 && !hasMonth {
+		if yday > 365 && !(yday == 366 && IsLeapYear(year)) {
+			return TAI{}, fmt.Errorf("Parse: day of year %d out of range for %d", yday, year)
+		}
+		days = DaysFromCivil64(year, January, 1) + int64(yday) - 1
+	} else {
+		if err := validCivil(year, month, day, hour, min, sec); err != nil {
+			return TAI{}, fmt.Errorf("Parse: %w", err)
+		}
+		days = DaysFromCivil64(year, month, day)
+		if yday != 0 && int64(yday) != days-DaysFromCivil64(year, January, 1)+1 {
+			return TAI{}, fmt.Errorf("Parse: day of year %d disagrees with the date", yday)
+		}
+	}
+	if hour > 23 || min > 59 {
+		return TAI{}, fmt.Errorf("Parse: time of day %02d:%02d out of range", hour, min)
+	}
+	if weekday >= 0 && weekday != weekdayFromDays64(days) {
+		return TAI{}, fmt.Errorf("Parse: weekday %s disagrees with the date", Weekday(weekday))
+	}
+	secs := SecsEpochFromDays64(days) + int64(hour)*Hour + int64(min)*Minute + int64(sec)
+	return Tai(secs, asec), nil
+}
+
+// trimmedFraction returns true if layout[i:] begins with a %N specifier with
+// the - flag
+func trimmedFraction(layout string, i int) bool {
+	if i+1 >= len(layout) || layout[i] != '%' || layout[i+1] != '-' {
+		return false
+	}
+	_, _, _, ok := parseFracSpec(layout, i+1)
+	return ok
+}
+
+// name consumes the longest of names, compared case insensitively, and
+// returns its index
+func (p *parser) name(names []string) int {
+	best := -1
+	for i, n := range names {
+		if len(p.s)-p.i >= len(n) && strings.EqualFold(p.s[p.i:p.i+len(n)], n) && (best < 0 || len(n) > len(names[best])) {
+			best = i
+		}
+	}
+	if p.err == nil && best < 0 {
+		p.fail("expected one of " + strings.Join(names, ", "))
+		return 0
+	}
+	if best >= 0 {
+		p.i += len(names[best])
+	}
+	return best
+}
+
+// monthAbbrev consumes an abbreviated month name of names, or the first three
+// letters of a longer one, such as the Sep of syslog for Sept
+func (p *parser) monthAbbrev(names []string) int {
+	if p.err != nil {
+		return 0
+	}
+	rest := p.s[p.i:]
+	for _, n := range names {
+		if len(rest) >= len(n) && strings.EqualFold(rest[:len(n)], n) {
+			return p.name(names)
+		}
+	}
+	for i, n := range names {
+		if len(n) > 3 && len(rest) >= 3 && isASCII(n[:3]) && strings.EqualFold(rest[:3], n[:3]) {
+			p.i += 3
+			return i
+		}
+	}
+	return p.name(names)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// year consumes a year with an optional sign; exactly four digits if fixed,
+// otherwise as many as are present
+func (p *parser) year(fixed bool) int {
+	neg := p.peek() == '-'
+	if neg {
+		p.next()
+	}
+	var y int
+	if fixed {
+		y = p.digits(4)
+	} else {
+		y = p.number(18)
+	}
+	if neg {
+		y = -y
+	}
+	return y
+}
+
+// number consumes an unsigned decimal of one to max digits
+func (p *parser) number(max int) int {
+	v := p.digits(1)
+	for n := 1; n < max; n++ {
+		if c := p.peek(); c < '0' || c > '9' {
+			break
+		}
+		v = v*10 + p.digits(1)
+	}
+	return v
+}
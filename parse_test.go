@@ -0,0 +1,164 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	ta := tai.Date(2024, 9, 3).AddHMS(15, 4, 5).Add(0, 123456789012345678)
+	cases := []struct {
+		descr  string
+		layout string
+		exp    tai.TAI
+	}{
+		{"RFC3339Nano", tai.RFC3339Nano, ta.Add(0, -12345678)},
+		{"RFC3339Micro", tai.RFC3339Micro, ta.Add(0, -789012345678)},
+		{"RFC3339", tai.RFC3339, tai.Date(2024, 9, 3).AddHMS(15, 4, 5)},
+		{"Compact", "%Y%m%d%H%M%S", tai.Date(2024, 9, 3).AddHMS(15, 4, 5)},
+		{"Names", "%A, %d %B %Y %I:%M:%S %p", tai.Date(2024, 9, 3).AddHMS(15, 4, 5)},
+		{"AbbrevNames", "%a %b %d %y %H:%M", tai.Date(2024, 9, 3).AddHMS(15, 4, 0)},
+		{"Ordinal", "%Y-%j %H:%M:%S", tai.Date(2024, 9, 3).AddHMS(15, 4, 5)},
+		{"Percent", "%Y%%%m%%%d", tai.Date(2024, 9, 3)},
+		{"TimeOnly", "%H:%M", tai.Date(1958, 1, 1).AddHMS(15, 4, 0)},
+		{"OrdinalDay", "%A, %B %o, %Y", tai.Date(2024, 9, 3)},
+		{"FIX", tai.FIX, ta.Add(0, -345678)},
+		{"Syslog", tai.Syslog, tai.Date(1958, 9, 3).AddHMS(15, 4, 5)},
+		{"Era", "%d %b %EY", tai.Date(2024, 9, 3)},
+		{"EraParts", "%EC %Ey-%m-%d", tai.Date(2024, 9, 3)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			s := ta.Format(tc.layout)
+			got, err := tai.Parse(tc.layout, s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(tc.exp) {
+				t.Fatalf("parsing %q: expected %+v, got %+v", s, tc.exp.AsGregorian(), got.AsGregorian())
+			}
+		})
+	}
+}
+
+func TestParseFarDates(t *testing.T) {
+	for _, ta := range []tai.TAI{tai.Date(-44, 3, 15), tai.Date(5, 6, 7), tai.Date(12345, 1, 1).AddHMS(1, 2, 3)} {
+		s := ta.Format(tai.RFC3339)
+		got, err := tai.Parse(tai.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Eq(ta) {
+			t.Errorf("parsing %q: expected %+v, got %+v", s, ta.AsGregorian(), got.AsGregorian())
+		}
+	}
+}
+
+// a year fixed at four digits by a following %e, which may begin with a digit
+func TestParseYearBeforeSpacePaddedDay(t *testing.T) {
+	for _, ta := range []tai.TAI{tai.Date(2024, 9, 3), tai.Date(2024, 9, 13)} {
+		s := ta.Format("%Y%e%m")
+		got, err := tai.Parse("%Y%e%m", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Eq(ta) {
+			t.Errorf("parsing %q: expected %+v, got %+v", s, ta.AsGregorian(), got.AsGregorian())
+		}
+	}
+}
+
+func TestParseEra(t *testing.T) {
+	got, err := tai.Parse("%d %B %EY", "15 March 44 BCE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.Date(-43, 3, 15); !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp.AsGregorian(), got.AsGregorian())
+	}
+}
+
+func TestParseShortYear(t *testing.T) {
+	cases := []struct {
+		in  string
+		exp int
+	}{{"68", 2068}, {"69", 1969}, {"00", 2000}, {"99", 1999}}
+	for _, tc := range cases {
+		got, err := tai.Parse("%y", tc.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y := got.AsGregorian().Year; y != tc.exp {
+			t.Errorf("%s: expected %d, got %d", tc.in, tc.exp, y)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	cases := []struct {
+		descr  string
+		layout string
+		in     string
+	}{
+		{"Empty", tai.RFC3339, ""},
+		{"Trailing", tai.RFC3339, "2024-09-03T15:04:05Zjunk"},
+		{"BadMonth", "%Y-%m-%d", "2024-13-01"},
+		{"BadDay", "%Y-%m-%d", "2023-02-29"},
+		{"BadHour", "%H:%M", "24:00"},
+		{"LeapSecond", "%H:%M:%S", "23:59:60"},
+		{"ShortFraction", tai.RFC3339Nano, "2024-09-03T15:04:05.123Z"},
+		{"WrongWeekday", "%a %Y-%m-%d", "Mon 2024-09-03"},
+		{"WrongYearDay", "%Y-%m-%d %j", "2024-09-03 001"},
+		{"YearDayOutOfRange", "%Y %j", "2023 366"},
+		{"BadName", "%B", "Smarch"},
+		{"UnknownSpecifier", "%Q", "x"},
+		{"Literal", "%Y/%m", "2024-09"},
+		{"WrongOrdinal", "%o %B %Y", "3th July 2024"},
+		{"WrongEra", "%Y %EC", "2024 BCE"},
+		{"EraYearZero", "%EY", "0 BCE"},
+		{"UnknownEraSpecifier", "%Ex", "x"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if _, err := tai.Parse(tc.layout, tc.in); err == nil {
+				t.Fatalf("expected error parsing %q with %q", tc.in, tc.layout)
+			}
+		})
+	}
+}
+
+func TestParseFractions(t *testing.T) {
+	ta := tai.Date(2024, 7, 1).AddHMS(12, 5, 9).Add(0, 123456789012345678)
+	cases := []struct {
+		layout string
+		exp    tai.TAI
+	}{
+		{tai.RFC3339Milli, ta.Add(0, -456789012345678)},
+		{tai.RFC3339Atto, ta},
+		{tai.RFC3339Trimmed, ta},
+	}
+	for _, tc := range cases {
+		got, err := tai.Parse(tc.layout, ta.Format(tc.layout))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Eq(tc.exp) {
+			t.Errorf("%s: expected %+v, got %+v", tc.layout, tc.exp, got)
+		}
+	}
+	for _, s := range []string{"2024-07-01T12:05:09Z", "2024-07-01T12:05:09.5Z", "2024-07-01T12:05:09.000000000000000001Z"} {
+		got, err := tai.Parse(tai.RFC3339Trimmed, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Format(tai.RFC3339Trimmed) != s {
+			t.Errorf("%s did not round trip, got %s", s, got.Format(tai.RFC3339Trimmed))
+		}
+	}
+	for _, layout := range []string{"%19N", "%0N", "%-3d", "%3"} {
+		if _, err := tai.Parse(layout, "1"); err == nil {
+			t.Errorf("expected error for layout %q", layout)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package tai
+
+import "fmt"
+
+// TAI64NLayout names the textual TAI64N label, e.g.
+// @4000000037c219bf2ef02e94, in the layouts of ParseAny and DetectLayout.
+// It is not a layout of Format or Parse; see ParseTAI64NLabel.
+const TAI64NLayout = "@tai64n"
+
+// DefaultLayouts are the layouts tried by ParseAny and DetectLayout when none
+// are given, in order: RFC 3339 with or without a fractional second or "Z",
+// and with a 'T' or space separator, FIX, Syslog, and TAI64NLayout.
+var DefaultLayouts = []string{
+	RFC3339Trimmed,
+	"%Y-%m-%d %H:%M:%S.%-18N%Z",
+	"%Y-%m-%dT%H:%M:%S.%-18N",
+	"%Y-%m-%d %H:%M:%S.%-18N",
+	FIX,
+	Syslog,
+	TAI64NLayout,
+}
+
+// ParseAny parses s with the first of layouts that matches it, or
+// DefaultLayouts if none are given.  Layouts are those of Parse, and s is
+// interpreted in the TAI calendar as by Parse, except for TAI64NLayout.
+func ParseAny(s string, layouts ...string) (TAI, error) {
+	t, _, err := parseAny(s, layouts)
+	if err != nil {
+		return TAI{}, fmt.Errorf("ParseAny: %w", err)
+	}
+	return t, nil
+}
+
+// DetectLayout returns the first of layouts that matches s, or of
+// DefaultLayouts if none are given.  A service facing several upstream formats
+// may detect the layout of a stream once and parse the rest with it.
+func DetectLayout(s string, layouts ...string) (string, error) {
+	_, layout, err := parseAny(s, layouts)
+	if err != nil {
+		return "", fmt.Errorf("DetectLayout: %w", err)
+	}
+	return layout, nil
+}
+
+func parseAny(s string, layouts []string) (TAI, string, error) {
+	if len(layouts) == 0 {
+		layouts = DefaultLayouts
+	}
+	loc := currentConfig().locale()
+	for _, layout := range layouts {
+		if layout == TAI64NLayout {
+			if t, err := ParseTAI64NLabel(s); err == nil {
+				return t, layout, nil
+			}
+			continue
+		}
+		// each layout is tried quietly, so that those that do not match
+		// cost no allocations
+		p := parser{s: s, quiet: true}
+		if t, err := parseWith(&p, layout, loc, true); err == nil {
+			return t, layout, nil
+		}
+	}
+	return TAI{}, "", fmt.Errorf("%q matches none of %d layouts", s, len(layouts))
+}
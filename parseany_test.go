@@ -0,0 +1,63 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestParseAny(t *testing.T) {
+	ta := tai.Date(2024, 9, 3).AddHMS(15, 4, 5)
+	frac := ta.Add(0, 5e17)
+	cases := []struct {
+		descr  string
+		in     string
+		layout string
+		exp    tai.TAI
+	}{
+		{"RFC3339", "2024-09-03T15:04:05Z", tai.RFC3339Trimmed, ta},
+		{"RFC3339Nano", "2024-09-03T15:04:05.500000000Z", tai.RFC3339Trimmed, frac},
+		{"Space", "2024-09-03 15:04:05.5Z", "%Y-%m-%d %H:%M:%S.%-18N%Z", frac},
+		{"NoZone", "2024-09-03T15:04:05", "%Y-%m-%dT%H:%M:%S.%-18N", ta},
+		{"SpaceNoZone", "2024-09-03 15:04:05.5", "%Y-%m-%d %H:%M:%S.%-18N", frac},
+		{"FIX", "20240903-15:04:05.500", tai.FIX, frac},
+		{"Syslog", "Sep  3 15:04:05", tai.Syslog, tai.Date(1958, 9, 3).AddHMS(15, 4, 5)},
+		{"TAI64N", string(ta.AppendTAI64NLabel(nil)), tai.TAI64NLayout, ta},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, err := tai.ParseAny(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp.AsGregorian(), got.AsGregorian())
+			}
+			layout, err := tai.DetectLayout(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if layout != tc.layout {
+				t.Fatalf("expected layout %q, got %q", tc.layout, layout)
+			}
+		})
+	}
+}
+
+func TestParseAnyLayouts(t *testing.T) {
+	got, err := tai.ParseAny("03/09/2024", "%Y-%m-%d", "%d/%m/%Y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.Date(2024, 9, 3); !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+	if _, err := tai.ParseAny("2024-09-03", "%d/%m/%Y"); err == nil {
+		t.Fatal("expected an error for a layout that does not match")
+	}
+	for _, s := range []string{"", "yesterday", "2024-13-03T15:04:05Z", "@4000000037c219bf2ef02e9"} {
+		if l, err := tai.DetectLayout(s); err == nil {
+			t.Errorf("expected no layout for %q, got %q", s, l)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package tai
+
+import "unsafe"
+
+// ParseBytes is func Parse for a byte slice, such as a field of a log line.
+// b is scanned in place, without being copied to a string, and is not
+// retained; errors are those of Parse.
+func ParseBytes(layout string, b []byte) (TAI, error) {
+	return parseLocale(layout, bytesString(b), currentConfig().locale())
+}
+
+// ParseTemporalInstantBytes is func ParseTemporalInstant for a byte slice.  b
+// is scanned in place, and is not retained.
+func ParseTemporalInstantBytes(b []byte) (TAI, error) {
+	return ParseTemporalInstant(bytesString(b))
+}
+
+// ParseBytes is func ParseBytes with the configuration of c
+func (c *Converter) ParseBytes(layout string, b []byte) (TAI, error) {
+	return parseLocale(layout, bytesString(b), &c.config.Locale)
+}
+
+// bytesString returns a string that shares the memory of b.  The parsers do
+// not retain their input, and any error copies it as it is formatted, so the
+// string does not outlive the call it is passed to.
+func bytesString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return *(*string)(unsafe.Pointer(&b))
+}
@@ -0,0 +1,86 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestParseBytes(t *testing.T) {
+	cases := []struct {
+		descr  string
+		layout string
+		in     string
+	}{
+		{"RFC3339Nano", tai.RFC3339Nano, "2024-09-03T15:04:05.123456789Z"},
+		{"Names", "%A, %d %B %Y %I:%M:%S %p", "Tuesday, 03 September 2024 03:04:05 PM"},
+		{"Invalid", tai.RFC3339, "2024-09-03T15:04:05Zjunk"},
+		{"Empty", tai.RFC3339, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			exp, experr := tai.Parse(tc.layout, tc.in)
+			b := []byte(tc.in)
+			got, err := tai.ParseBytes(tc.layout, b)
+			if (err == nil) != (experr == nil) {
+				t.Fatalf("expected error %v, got %v", experr, err)
+			}
+			if !got.Eq(exp) {
+				t.Fatalf("expected %+v, got %+v", exp, got)
+			}
+			if got, _ := tai.Snapshot().ParseBytes(tc.layout, b); !got.Eq(exp) {
+				t.Fatalf("expected %+v from a Converter, got %+v", exp, got)
+			}
+			if err != nil {
+				// the error must not share the memory of b
+				msg := err.Error()
+				for i := range b {
+					b[i] = 'x'
+				}
+				if err.Error() != msg {
+					t.Fatalf("error changed with its input: %q, then %q", msg, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestParseTemporalInstantBytes(t *testing.T) {
+	in := "2021-09-03T22:03:56.991894Z"
+	exp, err := tai.ParseTemporalInstant(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := tai.ParseTemporalInstantBytes([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+	if _, err := tai.ParseTemporalInstantBytes(nil); err == nil {
+		t.Fatal("expected an error for empty input")
+	}
+}
+
+func TestParseBytesAllocs(t *testing.T) {
+	b := []byte("2024-09-03T15:04:05.123456789Z")
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := tai.ParseBytes(tai.RFC3339Nano, b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tai.ParseTemporalInstantBytes(b); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	line := []byte("2024-09-03T15:04:05.123456789Z")
+	for i := 0; i < b.N; i++ {
+		tai.ParseBytes(tai.RFC3339Nano, line)
+	}
+}
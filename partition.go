@@ -0,0 +1,160 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Partitioner maps instants to the hierarchical paths of time partitioned
+// data, e.g. 2024/07/01/13, and paths back to the span of time they hold
+type Partitioner interface {
+	// AppendPath appends the path of the partition containing t to b
+	AppendPath(b []byte, t TAI) []byte
+	// ParsePath returns the span [start, end) of the partition with path
+	ParsePath(path string) (start, end TAI, err error)
+}
+
+const (
+	// PartitionYearly and the following are the layouts of the usual
+	// calendar partitions
+	PartitionYearly  = "%Y"
+	PartitionMonthly = "%Y/%m"
+	PartitionDaily   = "%Y/%m/%d"
+	PartitionHourly  = "%Y/%m/%d/%H"
+)
+
+// CalendarPartition partitions time by the TAI calendar.  The span of each
+// partition is set by the finest field of Layout; a Layout of "%Y/%m/%d/%H"
+// has hourly partitions.
+type CalendarPartition struct {
+	// Layout is the format of the path; see func Format.  It should contain
+	// only numeric specifiers, with each field coarser than the next, and
+	// should be one of the Partition constants in most cases.
+	Layout string
+}
+
+// AppendPath appends the path of the partition containing t to b, without
+// allocating if b has capacity
+func (p CalendarPartition) AppendPath(b []byte, t TAI) []byte {
+	return t.AppendFormat(b, p.Layout)
+}
+
+// ParsePath returns the span [start, end) of the partition with path
+func (p CalendarPartition) ParsePath(path string) (start, end TAI, err error) {
+	start, err = Parse(p.Layout, path)
+	if err != nil {
+		return TAI{}, TAI{}, fmt.Errorf("ParsePath: %w", err)
+	}
+	g := start.AsGregorian()
+	switch finestField(p.Layout) {
+	case 'S':
+		end = start.Add(Second, 0)
+	case 'M':
+		end = start.Add(Minute, 0)
+	case 'H', 'I':
+		end = start.Add(Hour, 0)
+	case 'd', 'j':
+		end = start.Add(Day, 0)
+	case 'm', 'b', 'B':
+		y, m := g.Year, int(g.Month)+1
+		if m > December {
+			y, m = y+1, January
+		}
+		end = Date(y, m, 1)
+	case 'Y', 'y':
+		end = Date(g.Year+1, January, 1)
+	default:
+		return TAI{}, TAI{}, errors.New("ParsePath: layout has no calendar fields")
+	}
+	return start, end, nil
+}
+
+// finestField returns the specifier of the shortest calendar field in layout
+func finestField(layout string) byte {
+	const order = "YymbBdjHIMS"
+	best := -1
+	for i := 0; i+1 < len(layout); i++ {
+		if layout[i] != '%' {
+			continue
+		}
+		i++
+		if k := strings.IndexByte(order, layout[i]); k > best {
+			best = k
+		}
+	}
+	if best < 0 {
+		return 0
+	}
+	return order[best]
+}
+
+// MissionDayPartition partitions time by the number of whole days since a
+// mission epoch, e.g. a path of 0123 for the 124th day of the mission, or
+// 0123/05 with Hours.  Times before the epoch have negative day numbers.
+type MissionDayPartition struct {
+	// Epoch is the start of day zero
+	Epoch TAI
+	// Width is the minimum number of digits of the day number, zero padded;
+	// zero is treated as 4
+	Width int
+	// Hours adds a second level of hourly partitions
+	Hours bool
+}
+
+// AppendPath appends the path of the partition containing t to b, without
+// allocating if b has capacity
+func (p MissionDayPartition) AppendPath(b []byte, t TAI) []byte {
+	width := p.Width
+	if width == 0 {
+		width = 4
+	}
+	d := sub(t, p.Epoch)
+	days, rem := floorDiv(d.sec, Day)
+	b = appendInt(b, days, width)
+	if p.Hours {
+		b = append(b, '/')
+		b = appendInt(b, rem/Hour, 2)
+	}
+	return b
+}
+
+// ParsePath returns the span [start, end) of the partition with path
+func (p MissionDayPartition) ParsePath(path string) (start, end TAI, err error) {
+	ps := parser{s: path}
+	neg := ps.peek() == '-'
+	if neg {
+		ps.next()
+	}
+	var days int64
+	n := 0
+	for c := ps.peek(); c >= '0' && c <= '9' && n < 15; c = ps.peek() {
+		days = days*10 + int64(ps.digits(1))
+		n++
+	}
+	if n == 0 {
+		ps.fail("expected mission day")
+	}
+	if neg {
+		days = -days
+	}
+	var hour int64
+	if p.Hours {
+		ps.expect('/')
+		hour = int64(ps.digits(2))
+		if hour > 23 {
+			ps.fail("hour out of range")
+		}
+	}
+	if ps.err == nil && ps.i != len(path) {
+		ps.fail("unexpected trailing characters")
+	}
+	if ps.err != nil {
+		return TAI{}, TAI{}, fmt.Errorf("ParsePath: %w", ps.err)
+	}
+	start = p.Epoch.Add(days*Day+hour*Hour, 0)
+	if p.Hours {
+		return start, start.Add(Hour, 0), nil
+	}
+	return start, start.Add(Day, 0), nil
+}
@@ -0,0 +1,99 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCalendarPartition(t *testing.T) {
+	ta := tai.Date(2024, 12, 31).AddHMS(13, 45, 10).Add(0, 5)
+	cases := []struct {
+		layout     string
+		path       string
+		start, end tai.TAI
+	}{
+		{tai.PartitionYearly, "2024", tai.Date(2024, 1, 1), tai.Date(2025, 1, 1)},
+		{tai.PartitionMonthly, "2024/12", tai.Date(2024, 12, 1), tai.Date(2025, 1, 1)},
+		{tai.PartitionDaily, "2024/12/31", tai.Date(2024, 12, 31), tai.Date(2025, 1, 1)},
+		{tai.PartitionHourly, "2024/12/31/13", tai.Date(2024, 12, 31).AddHMS(13, 0, 0), tai.Date(2024, 12, 31).AddHMS(14, 0, 0)},
+		{"year=%Y/month=%m/day=%d", "year=2024/month=12/day=31", tai.Date(2024, 12, 31), tai.Date(2025, 1, 1)},
+		{"%Y/%j", "2024/366", tai.Date(2024, 12, 31), tai.Date(2025, 1, 1)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.layout, func(t *testing.T) {
+			p := tai.CalendarPartition{Layout: tc.layout}
+			if got := string(p.AppendPath(nil, ta)); got != tc.path {
+				t.Fatalf("expected path %s, got %s", tc.path, got)
+			}
+			start, end, err := p.ParsePath(tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !start.Eq(tc.start) || !end.Eq(tc.end) {
+				t.Fatalf("expected [%+v, %+v), got [%+v, %+v)", tc.start.AsGregorian(), tc.end.AsGregorian(), start.AsGregorian(), end.AsGregorian())
+			}
+			if ta.Before(start) || !ta.Before(end) {
+				t.Fatal("partition does not contain the instant")
+			}
+		})
+	}
+}
+
+func TestCalendarPartitionInvalid(t *testing.T) {
+	if _, _, err := (tai.CalendarPartition{Layout: tai.PartitionDaily}).ParsePath("2024/13/01"); err == nil {
+		t.Fatal("expected error for an invalid month")
+	}
+	if _, _, err := (tai.CalendarPartition{Layout: "data"}).ParsePath("data"); err == nil {
+		t.Fatal("expected error for a layout without calendar fields")
+	}
+}
+
+func TestMissionDayPartition(t *testing.T) {
+	epoch := tai.Date(2024, 7, 1).AddHMS(6, 0, 0)
+	cases := []struct {
+		descr      string
+		p          tai.MissionDayPartition
+		t          tai.TAI
+		path       string
+		start, end tai.TAI
+	}{
+		{"DayZero", tai.MissionDayPartition{Epoch: epoch}, epoch.AddHMS(1, 0, 0), "0000", epoch, epoch.Add(tai.Day, 0)},
+		{"Day123", tai.MissionDayPartition{Epoch: epoch}, epoch.Add(123*tai.Day+5, 0), "0123", epoch.Add(123*tai.Day, 0), epoch.Add(124*tai.Day, 0)},
+		{"Hours", tai.MissionDayPartition{Epoch: epoch, Hours: true}, epoch.Add(2*tai.Day+5*tai.Hour+30, 0), "0002/05", epoch.Add(2*tai.Day+5*tai.Hour, 0), epoch.Add(2*tai.Day+6*tai.Hour, 0)},
+		{"BeforeEpoch", tai.MissionDayPartition{Epoch: epoch, Width: 3}, epoch.Add(-1, 0), "-001", epoch.Add(-tai.Day, 0), epoch},
+		{"Wide", tai.MissionDayPartition{Epoch: epoch, Width: 2}, epoch.Add(12345*tai.Day, 0), "12345", epoch.Add(12345*tai.Day, 0), epoch.Add(12346*tai.Day, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := string(tc.p.AppendPath(nil, tc.t)); got != tc.path {
+				t.Fatalf("expected path %s, got %s", tc.path, got)
+			}
+			start, end, err := tc.p.ParsePath(tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !start.Eq(tc.start) || !end.Eq(tc.end) {
+				t.Fatalf("expected [%+v, %+v), got [%+v, %+v)", tc.start, tc.end, start, end)
+			}
+		})
+	}
+	for _, s := range []string{"", "x", "0001/", "0001/24", "0001x"} {
+		if _, _, err := (tai.MissionDayPartition{Hours: true}).ParsePath(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
+
+func TestPartitionAppendPathDoesNotAllocate(t *testing.T) {
+	ta := tai.Date(2024, 7, 1)
+	b := make([]byte, 0, 64)
+	for _, p := range []tai.Partitioner{tai.CalendarPartition{Layout: tai.PartitionHourly}, tai.MissionDayPartition{Hours: true}} {
+		allocs := testing.AllocsPerRun(100, func() {
+			b = p.AppendPath(b[:0], ta)
+		})
+		if allocs != 0 {
+			t.Errorf("%T: expected no allocations, got %v", p, allocs)
+		}
+	}
+}
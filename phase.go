@@ -0,0 +1,42 @@
+package tai
+
+// PhaseIn returns the phase of t within a cycle of the given period that
+// repeats from origin, that is the time elapsed since the most recent cycle
+// boundary at or before t.  The result is exact to the attosecond for any
+// period and any separation of t and origin, and lies in [0, period) for a
+// positive period or (period, 0] for a negative one.
+//
+// PhaseIn panics if period is zero.
+func PhaseIn(t TAI, period Duration, origin TAI) Duration {
+	// the remainder of divmod is exact even when the cycle count overflows
+	_, r := divmod(sub(t, origin), period)
+	return r
+}
+
+// NextEdge returns the first cycle boundary, origin + k*period for some
+// integer k, that is strictly after the given instant.  Paired with AfterFunc
+// it fires on exact boundaries, such as whole TAI seconds for a PPS signal or
+// 10 ms frames aligned to origin.
+//
+// NextEdge panics if period is zero.
+func NextEdge(after TAI, period Duration, origin TAI) TAI {
+	if period.IsNegative() {
+		period = period.Neg()
+	}
+	return after.AddDuration(period.Sub(PhaseIn(after, period, origin)))
+}
+
+// PrevEdge returns the last cycle boundary, origin + k*period for some integer
+// k, that is strictly before the given instant.
+//
+// PrevEdge panics if period is zero.
+func PrevEdge(before TAI, period Duration, origin TAI) TAI {
+	if period.IsNegative() {
+		period = period.Neg()
+	}
+	r := PhaseIn(before, period, origin)
+	if r == (Duration{}) {
+		r = period
+	}
+	return before.AddDuration(r.Neg())
+}
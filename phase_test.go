@@ -0,0 +1,68 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestPhaseIn(t *testing.T) {
+	origin := tai.Date(2024, 7, 1)
+	period := tai.Dur(0, 10*tai.Millisecond)
+	cases := []struct {
+		descr  string
+		t      tai.TAI
+		period tai.Duration
+		exp    tai.Duration
+	}{
+		{"OnOrigin", origin, period, tai.Duration{}},
+		{"WithinCycle", origin.Add(0, 25*tai.Millisecond+1), period, tai.Dur(0, 5*tai.Millisecond+1)},
+		{"BeforeOrigin", origin.Add(0, -1), period, tai.Dur(0, 10*tai.Millisecond-1)},
+		{"NegativePeriod", origin.Add(0, 1), period.Neg(), tai.Dur(0, 1-10*tai.Millisecond)},
+		{"LongPeriod", origin.Add(3*86400, 7), tai.Dur(86400, 1), tai.Dur(0, 4)},
+		{"AttosecondPeriodFarAway", origin.Add(1e12, 7), tai.Dur(0, 3), tai.Dur(0, 2)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tai.PhaseIn(tc.t, tc.period, origin); !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestPhaseInZeroPeriodPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for zero period")
+		}
+	}()
+	tai.PhaseIn(tai.Date(2024, 7, 1), tai.Duration{}, tai.Date(2024, 1, 1))
+}
+
+func TestEdges(t *testing.T) {
+	origin := tai.Date(2024, 7, 1)
+	period := tai.Dur(0, 10*tai.Millisecond)
+	mid := origin.Add(0, 25*tai.Millisecond+1)
+	cases := []struct {
+		descr string
+		got   tai.TAI
+		exp   tai.TAI
+	}{
+		{"NextWithinCycle", tai.NextEdge(mid, period, origin), origin.Add(0, 30*tai.Millisecond)},
+		{"NextOnEdge", tai.NextEdge(origin, period, origin), origin.Add(0, 10*tai.Millisecond)},
+		{"NextBeforeOrigin", tai.NextEdge(origin.Add(0, -1), period, origin), origin},
+		{"NextNegativePeriod", tai.NextEdge(mid, period.Neg(), origin), origin.Add(0, 30*tai.Millisecond)},
+		{"PrevWithinCycle", tai.PrevEdge(mid, period, origin), origin.Add(0, 20*tai.Millisecond)},
+		{"PrevOnEdge", tai.PrevEdge(origin, period, origin), origin.Add(0, -10*tai.Millisecond)},
+		{"PrevNegativePeriod", tai.PrevEdge(mid, period.Neg(), origin), origin.Add(0, 20*tai.Millisecond)},
+		{"NextPPS", tai.NextEdge(origin.Add(0, tai.Nanosecond), tai.Dur(1, 0), tai.TAI{}), origin.Add(1, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if !tc.got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, tc.got)
+			}
+		})
+	}
+}
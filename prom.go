@@ -0,0 +1,14 @@
+package tai
+
+// FromPromMillis returns the TAI time of a Prometheus sample timestamp, the
+// number of milliseconds since the UNIX epoch in the UTC time system.  The
+// leap second table is consulted in making the conversion; see func Unix.
+func FromPromMillis(ms int64) TAI {
+	return FromJSMillis(ms)
+}
+
+// ToPromMillis returns t as a Prometheus sample timestamp, truncated toward
+// the past to milliseconds
+func (t TAI) ToPromMillis() int64 {
+	return t.ToJSMillis()
+}
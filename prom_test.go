@@ -0,0 +1,20 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestPromMillis(t *testing.T) {
+	for _, ms := range []int64{1719837296123, 0, -1} {
+		ta := tai.FromPromMillis(ms)
+		if exp := tai.FromTime(time.Unix(0, ms*1e6)); !ta.Eq(exp) {
+			t.Fatalf("%d: expected %v, got %v", ms, exp.AsTime(), ta.AsTime())
+		}
+		if got := ta.ToPromMillis(); got != ms {
+			t.Fatalf("expected %d, got %d", ms, got)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package tai
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// quickRange bounds the seconds of values generated for testing/quick, about
+// 35 thousand years either side of the epoch, so that generated values are
+// valid inputs to the calendar and formatting functions
+const quickRange = 1 << 40
+
+func quickParts(r *rand.Rand) (sec, asec int64) {
+	sec = r.Int63n(2*quickRange) - quickRange
+	switch r.Intn(4) {
+	case 0:
+		// whole seconds exercise the boundaries of the calendar
+	case 1:
+		asec = r.Int63n(1e9) * Nanosecond
+	default:
+		asec = r.Int63n(1e18)
+	}
+	return sec, asec
+}
+
+// Generate implements testing/quick.Generator.  The instants generated are
+// within about 35 thousand years of the epoch, and a portion of them fall on
+// whole seconds or nanoseconds.
+func (TAI) Generate(r *rand.Rand, size int) reflect.Value {
+	sec, asec := quickParts(r)
+	return reflect.ValueOf(TAI{sec: sec, asec: asec})
+}
+
+// Generate implements testing/quick.Generator.  The values generated are the
+// Gregorian breakdown of instants generated as by TAI.Generate, and so are
+// always valid dates.
+func (Gregorian) Generate(r *rand.Rand, size int) reflect.Value {
+	sec, asec := quickParts(r)
+	return reflect.ValueOf(TAI{sec: sec, asec: asec}.AsGregorian())
+}
+
+// Generate implements testing/quick.Generator, with the same range and
+// distribution as TAI.Generate
+func (Duration) Generate(r *rand.Rand, size int) reflect.Value {
+	sec, asec := quickParts(r)
+	return reflect.ValueOf(Duration{sec: sec, asec: asec})
+}
@@ -0,0 +1,37 @@
+package tai_test
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/brandondube/tai"
+)
+
+func TestQuickTAIDecimalRoundTrip(t *testing.T) {
+	f := func(ta tai.TAI) bool {
+		got, err := tai.ParseDecimal(ta.Decimal())
+		return err == nil && got.Eq(ta)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQuickGregorianValid(t *testing.T) {
+	f := func(g tai.Gregorian) bool {
+		return g.Month.Valid() && g.Day >= 1 && g.Day <= tai.DaysInMonth(int(g.Month), g.Year) &&
+			g.Hour < 24 && g.Min < 60 && g.Sec < 60 && g.Asec >= 0 && g.Asec < 1e18
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestQuickDurationAddSub(t *testing.T) {
+	f := func(ta tai.TAI, d tai.Duration) bool {
+		return ta.AddDuration(d).AddDuration(d.Neg()).Eq(ta)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,112 @@
+package tai
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter whose arithmetic is performed in
+// exact atomic time.  Tokens are replenished at one per the Every duration, up
+// to a maximum of Burst tokens.
+//
+// Limiter is implemented as the generic cell rate algorithm, tracking the
+// theoretical arrival time of the next token instead of a token count, so that
+// no division or floating point arithmetic is required.
+//
+// Limiter is safe for concurrent use.
+type Limiter struct {
+	mu    sync.Mutex
+	every Duration
+	burst int64
+	clock Clock
+	// tat is the theoretical arrival time; the bucket is full when tat <= now
+	tat TAI
+}
+
+// NewLimiter returns a Limiter that permits one event per every, with bursts
+// of up to burst events.  The clock is used by the methods that do not take
+// the current time as an argument; if nil, DefaultClock is used.
+//
+// NewLimiter panics if every is not positive or burst is less than one.
+func NewLimiter(every Duration, burst int, clock Clock) *Limiter {
+	if !(Duration{}).Less(every) || burst < 1 {
+		panic("tai.NewLimiter: every must be positive and burst at least one")
+	}
+	if clock == nil {
+		clock = DefaultClock
+	}
+	return &Limiter{every: every, burst: int64(burst), clock: clock}
+}
+
+// Allow is shorthand for AllowN(l's clock's Now(), 1)
+func (l *Limiter) Allow() bool {
+	return l.AllowN(l.clock.Now(), 1)
+}
+
+// AllowN reports whether n events may happen at time now, and if so consumes
+// n tokens.
+func (l *Limiter) AllowN(now TAI, n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tat, ok := l.reserve(now, int64(n))
+	if ok {
+		l.tat = tat
+	}
+	return ok
+}
+
+// Delay returns how long from now until n events may happen, zero if they
+// may happen immediately.  No tokens are consumed.
+//
+// if n exceeds the burst size the events can never happen, and false is
+// returned.
+func (l *Limiter) Delay(now TAI, n int) (Duration, bool) {
+	if int64(n) > l.burst {
+		return Duration{}, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tat, _ := l.reserve(now, int64(n))
+	allowAt := tat.AddDuration(l.every.Mul(-l.burst))
+	if !allowAt.After(now) {
+		return Duration{}, true
+	}
+	return sub(allowAt, now), true
+}
+
+// Wait blocks until an event may happen according to l's clock, consuming a
+// token, or until ctx is done.  Waiting is performed with the stdlib timer;
+// the delay is recomputed against the clock after each wake.
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		now := l.clock.Now()
+		if l.AllowN(now, 1) {
+			return nil
+		}
+		delay, _ := l.Delay(now, 1)
+		d := delay.AsStdDuration()
+		if d <= 0 {
+			d = time.Nanosecond
+		}
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve returns the new theoretical arrival time if n events happened at
+// now, and whether doing so is permitted.  l.mu must be held.
+func (l *Limiter) reserve(now TAI, n int64) (TAI, bool) {
+	tat := l.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	tat = tat.AddDuration(l.every.Mul(n))
+	allowAt := tat.AddDuration(l.every.Mul(-l.burst))
+	return tat, n <= l.burst && !allowAt.After(now)
+}
@@ -0,0 +1,63 @@
+package tai_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+type fakeClock struct {
+	now tai.TAI
+}
+
+func (c *fakeClock) Now() tai.TAI {
+	return c.now
+}
+
+func TestLimiterBurstAndRefill(t *testing.T) {
+	clock := &fakeClock{now: tai.Date(2024, 7, 1)}
+	l := tai.NewLimiter(tai.Dur(0, 100*tai.Millisecond), 3, clock)
+	for i := 0; i < 3; i++ {
+		if !l.Allow() {
+			t.Fatalf("event %d of the burst was not allowed", i)
+		}
+	}
+	if l.Allow() {
+		t.Fatal("event beyond the burst was allowed")
+	}
+	delay, ok := l.Delay(clock.now, 1)
+	if !ok || !delay.Eq(tai.Dur(0, 100*tai.Millisecond)) {
+		t.Fatalf("expected a delay of 100 ms, got %+v", delay)
+	}
+	clock.now = clock.now.Add(0, 100*tai.Millisecond-1)
+	if l.Allow() {
+		t.Fatal("event allowed one attosecond before refill")
+	}
+	clock.now = clock.now.Add(0, 1)
+	if !l.Allow() {
+		t.Fatal("event not allowed after refill")
+	}
+}
+
+func TestLimiterAllowNExceedsBurst(t *testing.T) {
+	l := tai.NewLimiter(tai.Dur(1, 0), 2, nil)
+	now := tai.Now()
+	if l.AllowN(now, 3) {
+		t.Fatal("allowed more events than the burst size")
+	}
+	if _, ok := l.Delay(now, 3); ok {
+		t.Fatal("expected Delay to report the events can never happen")
+	}
+}
+
+func TestLimiterWaitCanceled(t *testing.T) {
+	clock := &fakeClock{now: tai.Date(2024, 7, 1)}
+	l := tai.NewLimiter(tai.Dur(3600, 0), 1, clock)
+	l.Allow()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
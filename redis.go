@@ -0,0 +1,77 @@
+package tai
+
+import (
+	"math"
+	"time"
+)
+
+// Score returns t as an integer number of res-attosecond units since the TAI
+// epoch, for use as e.g. a Redis sorted set score.  res must evenly divide one
+// second, e.g. tai.Millisecond or 1e18 for whole seconds.  Sub-unit time is
+// truncated toward the past.
+//
+// Score is computed with wrapping int64 arithmetic and rolls over every 2^64
+// units.  The epoch-relative range before rollover is +/- 292 billion years at
+// second resolution, 292 million years at millisecond resolution, 292 years at
+// nanosecond resolution, and 9.2 seconds at attosecond resolution.
+//
+// Redis stores scores as float64, which represents integers exactly to 2^53;
+// at millisecond resolution scores are exact within +/- 285 thousand years of
+// the epoch, and at microsecond resolution within +/- 285 years.
+//
+// Score panics if res does not evenly divide one second.
+func (t TAI) Score(res int64) int64 {
+	checkScoreRes(res)
+	return t.sec*(1e18/res) + t.asec/res
+}
+
+// FromScore is the inverse of Score.  score must have been produced without
+// rollover for the result to be meaningful.
+//
+// FromScore panics if res does not evenly divide one second.
+func FromScore(score, res int64) TAI {
+	checkScoreRes(res)
+	sec, rem := floorDiv(score, 1e18/res)
+	return Tai(sec, rem*res)
+}
+
+func checkScoreRes(res int64) {
+	if res <= 0 || 1e18%res != 0 {
+		panic("tai.Score: resolution must evenly divide one second")
+	}
+}
+
+// TTL returns the time remaining from now until deadline, suitable for a
+// relative expiry such as the Redis PEXPIRE or EXPIRE commands.
+//
+// Since TAI is continuous, the result is the true elapsed time and is not
+// distorted by a leap second between now and deadline.  The TTL is rounded up
+// to the next nanosecond so that an expiry never precedes the deadline, zero
+// if the deadline has passed, and saturates at the maximum time.Duration.
+func TTL(now, deadline TAI) time.Duration {
+	if !deadline.After(now) {
+		return 0
+	}
+	sec := deadline.sec - now.sec
+	asec := deadline.asec - now.asec
+	if asec < 0 {
+		asec += 1e18
+		sec--
+	}
+	ns := (asec + Nanosecond - 1) / Nanosecond
+	if sec > (math.MaxInt64-ns)/1e9 {
+		return math.MaxInt64
+	}
+	return time.Duration(sec*1e9 + ns)
+}
+
+// ExpireAtMillis returns deadline as the number of milliseconds since the UNIX
+// epoch, suitable for an absolute expiry such as the Redis PEXPIREAT command.
+//
+// The leap second table is consulted in making the conversion, and the result
+// is rounded up to the next millisecond so that an expiry never precedes the
+// deadline.
+func ExpireAtMillis(deadline TAI) int64 {
+	secs, asec := deadline.unix()
+	return secs*1e3 + (asec+Millisecond-1)/Millisecond
+}
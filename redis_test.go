@@ -0,0 +1,74 @@
+package tai_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestScoreRoundTrip(t *testing.T) {
+	cases := []struct {
+		descr string
+		inp   tai.TAI
+		res   int64
+	}{
+		{"Millisecond", tai.Date(2024, 7, 1).Add(0, 123*tai.Millisecond), tai.Millisecond},
+		{"Microsecond", tai.Date(2024, 7, 1).Add(0, 123456*tai.Microsecond), tai.Microsecond},
+		{"Nanosecond", tai.Date(2024, 7, 1).Add(0, 1*tai.Nanosecond), tai.Nanosecond},
+		{"WholeSecondNegative", tai.Date(1900, 1, 1), 1e18},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			back := tai.FromScore(tc.inp.Score(tc.res), tc.res)
+			if !back.Eq(tc.inp) {
+				t.Fatalf("expected %+v, got %+v", tc.inp, back)
+			}
+		})
+	}
+}
+
+func TestScoreTruncates(t *testing.T) {
+	ta := tai.Tai(-1, 999*tai.Microsecond)
+	if s := ta.Score(tai.Millisecond); s != -1000 {
+		t.Fatalf("expected -1000, got %d", s)
+	}
+}
+
+func TestScoreInvalidResolutionPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for resolution of 7 attoseconds")
+		}
+	}()
+	tai.Now().Score(7)
+}
+
+func TestTTL(t *testing.T) {
+	now := tai.Date(2024, 7, 1)
+	cases := []struct {
+		descr    string
+		deadline tai.TAI
+		exp      time.Duration
+	}{
+		{"Future", now.AddHMS(0, 0, 90), 90 * time.Second},
+		{"RoundsUp", now.Add(0, 1), time.Nanosecond},
+		{"Past", now.AddHMS(0, 0, -1), 0},
+		{"Saturates", now.AddHMS(1e7, 0, 0), math.MaxInt64},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tai.TTL(now, tc.deadline); got != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestExpireAtMillis(t *testing.T) {
+	deadline := tai.FromJSMillis(1725401036991).Add(0, 1)
+	if got := tai.ExpireAtMillis(deadline); got != 1725401036992 {
+		t.Fatalf("expected 1725401036992, got %d", got)
+	}
+}
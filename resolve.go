@@ -0,0 +1,103 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UTCResolution is the policy of func ResolveUTC for a UTC wall time that
+// names more than one instant, or none
+type UTCResolution int
+
+const (
+	// ResolveEarliest chooses the earlier of two instants
+	ResolveEarliest UTCResolution = iota
+	// ResolveLatest chooses the later of two instants
+	ResolveLatest
+	// ResolveError returns ErrNonexistentUTC
+	ResolveError
+	// ResolveRepeatedEarliest is ResolveEarliest for a wall time read from a
+	// clock that repeats the second after a positive leap second
+	ResolveRepeatedEarliest
+	// ResolveRepeatedError is ResolveError for a wall time read from a clock
+	// that repeats the second after a positive leap second, also returning
+	// ErrAmbiguousUTC
+	ResolveRepeatedError
+)
+
+var (
+	// ErrAmbiguousUTC is returned by ResolveUTC with ResolveRepeatedError for
+	// a wall time that names two instants
+	ErrAmbiguousUTC = errors.New("tai: UTC time is ambiguous")
+	// ErrNonexistentUTC is returned by ResolveUTC for a wall time that names
+	// no instant
+	ErrNonexistentUTC = errors.New("tai: UTC time does not exist")
+)
+
+// ResolveUTC returns the instant of the UTC wall time g, applying policy if
+// the wall time does not exist because of a leap second, or is ambiguous on
+// a clock that cannot show one.
+//
+// a wall time does not exist if it is 23:59:60 on a day that does not end with
+// a positive leap second, or 23:59:59 on a day that ends with a negative one.
+// The policies other than the errors choose the instant at which the clock
+// skips over it, the start of the next day, as there is no instant between its
+// neighbors.
+//
+// in UTC, every other wall time names one instant; a positive leap second is
+// 23:59:60, and the second after it is that of the next day.  Clocks that
+// cannot show 23:59:60, such as time.Time and UNIX time, instead repeat the
+// first second of the next day during the leap, so a wall time read from one
+// in that second is ambiguous: the earlier instant is in the leap second, and
+// the later is the one UTC labels with the same wall time.  Only the Repeated
+// policies treat it as ambiguous, ResolveRepeatedEarliest choosing the leap
+// second; the others choose the instant UTC labels.
+//
+// if Config.Smear is SmearLinear, UTC is continuous: no wall time is
+// ambiguous, and no 23:59:60 exists.
+func ResolveUTC(g Gregorian, policy UTCResolution) (TAI, error) {
+	if err := validCivil(g.Year, int(g.Month), g.Day, g.Hour, g.Min, g.Sec); err != nil {
+		return TAI{}, fmt.Errorf("ResolveUTC: %w", err)
+	}
+	if g.Asec < 0 || g.Asec >= 1e18 {
+		return TAI{}, fmt.Errorf("ResolveUTC: attoseconds %d out of range", g.Asec)
+	}
+	if policy < ResolveEarliest || policy > ResolveRepeatedError {
+		return TAI{}, fmt.Errorf("ResolveUTC: unknown policy %d", int(policy))
+	}
+	smeared := currentConfig().Smear == SmearLinear
+	if g.Sec == 60 {
+		if g.Hour != 23 || g.Min != 59 {
+			return TAI{}, errors.New("ResolveUTC: a leap second must be 23:59:60")
+		}
+		midnight := unixFromCivil(g.Year, int(g.Month), g.Day, 23, 59, 59) + 1
+		if step, ok := LeapSecondAt(midnight); ok && step > 0 && !smeared {
+			return unixAsecWith(midnight-1, 0, SmearNone).Add(1, g.Asec), nil
+		}
+		return resolveNonexistent(midnight, policy)
+	}
+	secs := unixFromCivil(g.Year, int(g.Month), g.Day, g.Hour, g.Min, g.Sec)
+	if !smeared {
+		if inSkippedSecond(secs) {
+			return resolveNonexistent(secs+1, policy)
+		}
+		if step, ok := LeapSecondAt(secs); ok && step > 0 {
+			switch policy {
+			case ResolveRepeatedEarliest:
+				return unixAsecWith(secs-1, 0, SmearNone).Add(1, g.Asec), nil
+			case ResolveRepeatedError:
+				return TAI{}, ErrAmbiguousUTC
+			}
+		}
+	}
+	return unixAsec(secs, g.Asec), nil
+}
+
+// resolveNonexistent returns the instant of the UNIX time next, the first
+// that exists after a wall time that does not
+func resolveNonexistent(next int64, policy UTCResolution) (TAI, error) {
+	if policy == ResolveError || policy == ResolveRepeatedError {
+		return TAI{}, ErrNonexistentUTC
+	}
+	return unixAsec(next, 0), nil
+}
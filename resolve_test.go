@@ -0,0 +1,116 @@
+package tai_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+// leap2017 is the UNIX time of 2017-01-01, which follows a positive leap second
+const leap2017 = 1483228800
+
+func TestResolveUTC(t *testing.T) {
+	leapStart := tai.Unix(leap2017-1, 0).Add(1, 0)
+	after := tai.Unix(leap2017, 0)
+	cases := []struct {
+		descr  string
+		g      tai.Gregorian
+		policy tai.UTCResolution
+		exp    tai.TAI
+		err    error
+	}{
+		{"Ordinary", tai.Gregorian{Year: 2016, Month: tai.June, Day: 1, Hour: 12}, tai.ResolveError, tai.Unix(1464782400, 0), nil},
+		{"LeapSecond", tai.Gregorian{Year: 2016, Month: tai.December, Day: 31, Hour: 23, Min: 59, Sec: 60, Asec: 5e17}, tai.ResolveError, leapStart.Add(0, 5e17), nil},
+		{"AfterLeapEarliest", tai.Gregorian{Year: 2017, Month: tai.January, Day: 1, Asec: 5e17}, tai.ResolveEarliest, after.Add(0, 5e17), nil},
+		{"AfterLeapLatest", tai.Gregorian{Year: 2017, Month: tai.January, Day: 1, Asec: 5e17}, tai.ResolveLatest, after.Add(0, 5e17), nil},
+		{"AfterLeapError", tai.Gregorian{Year: 2017, Month: tai.January, Day: 1, Asec: 5e17}, tai.ResolveError, after.Add(0, 5e17), nil},
+		{"RepeatedEarliest", tai.Gregorian{Year: 2017, Month: tai.January, Day: 1, Asec: 5e17}, tai.ResolveRepeatedEarliest, leapStart.Add(0, 5e17), nil},
+		{"RepeatedError", tai.Gregorian{Year: 2017, Month: tai.January, Day: 1}, tai.ResolveRepeatedError, tai.TAI{}, tai.ErrAmbiguousUTC},
+		{"NonexistentLatest", tai.Gregorian{Year: 2016, Month: tai.June, Day: 30, Hour: 23, Min: 59, Sec: 60}, tai.ResolveLatest, tai.Unix(1467331200, 0), nil},
+		{"NonexistentEarliest", tai.Gregorian{Year: 2016, Month: tai.June, Day: 30, Hour: 23, Min: 59, Sec: 60, Asec: 1}, tai.ResolveEarliest, tai.Unix(1467331200, 0), nil},
+		{"NonexistentError", tai.Gregorian{Year: 2016, Month: tai.June, Day: 30, Hour: 23, Min: 59, Sec: 60}, tai.ResolveError, tai.TAI{}, tai.ErrNonexistentUTC},
+		{"NonexistentRepeatedError", tai.Gregorian{Year: 2016, Month: tai.June, Day: 30, Hour: 23, Min: 59, Sec: 60}, tai.ResolveRepeatedError, tai.TAI{}, tai.ErrNonexistentUTC},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, err := tai.ResolveUTC(tc.g, tc.policy)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if err == nil && !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestResolveUTCInvalid(t *testing.T) {
+	cases := []struct {
+		descr  string
+		g      tai.Gregorian
+		policy tai.UTCResolution
+	}{
+		{"Day", tai.Gregorian{Year: 2021, Month: tai.February, Day: 30}, tai.ResolveError},
+		{"Asec", tai.Gregorian{Year: 2021, Month: tai.February, Day: 1, Asec: -1}, tai.ResolveError},
+		{"SecondSixtyMidday", tai.Gregorian{Year: 2016, Month: tai.December, Day: 31, Hour: 12, Sec: 60}, tai.ResolveLatest},
+		{"Policy", tai.Gregorian{Year: 2021, Month: tai.February, Day: 1}, tai.UTCResolution(7)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if _, err := tai.ResolveUTC(tc.g, tc.policy); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestResolveUTCNegativeLeap(t *testing.T) {
+	withNegativeLeap(t, func() {
+		g := tai.Gregorian{Year: 2099, Month: tai.December, Day: 31, Hour: 23, Min: 59, Sec: 59, Asec: 3e17}
+		if _, err := tai.ResolveUTC(g, tai.ResolveError); !errors.Is(err, tai.ErrNonexistentUTC) {
+			t.Fatalf("expected ErrNonexistentUTC, got %v", err)
+		}
+		got, err := tai.ResolveUTC(g, tai.ResolveEarliest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exp := tai.Unix(negativeLeap, 0); !got.Eq(exp) {
+			t.Fatalf("expected the start of the next day %+v, got %+v", exp, got)
+		}
+		g.Sec = 58
+		got, err = tai.ResolveUTC(g, tai.ResolveError)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exp := tai.Unix(negativeLeap-2, 300000000); !got.Eq(exp) {
+			t.Fatalf("expected %+v, got %+v", exp, got)
+		}
+	})
+}
+
+func TestResolveUTCSmear(t *testing.T) {
+	defer restoreConfig(t)
+	if err := tai.Configure(tai.WithSmear(tai.SmearLinear)); err != nil {
+		t.Fatal(err)
+	}
+	g := tai.Gregorian{Year: 2017, Month: tai.January, Day: 1}
+	got, err := tai.ResolveUTC(g, tai.ResolveError)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.Unix(leap2017, 0); !got.Eq(exp) {
+		t.Fatalf("expected the smeared midnight %+v, got %+v", exp, got)
+	}
+	g = tai.Gregorian{Year: 2016, Month: tai.December, Day: 31, Hour: 23, Min: 59, Sec: 60}
+	if _, err := tai.ResolveUTC(g, tai.ResolveError); !errors.Is(err, tai.ErrNonexistentUTC) {
+		t.Fatalf("expected ErrNonexistentUTC for a smeared leap second, got %v", err)
+	}
+	got, err = tai.ResolveUTC(g, tai.ResolveLatest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := tai.Unix(leap2017, 0); !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+}
@@ -0,0 +1,108 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ValidateRFC3339 returns an error if s is not a valid RFC 3339 date-time, e.g.
+// 2021-09-03T22:03:56.991894+02:00, without constructing a TAI.  It is a cheap
+// check for inputs that must be rejected before further processing, and does
+// not allocate for valid inputs.
+//
+// the grammar of RFC 3339 section 5.6 is followed exactly: a four digit year,
+// a 'T' separator, any number of fractional digits, and either 'Z' or a
+// numeric offset of at most 23:59.  Letters may be of either case.  The date
+// must exist, and the rules of section 5.7 apply to leap seconds: :60 is only
+// valid at the end of a UTC day with a positive leap second in the table, or
+// at the end of any month after the table expires, adjusted for the offset.
+// The second omitted by a negative leap second is not valid.
+func ValidateRFC3339(s string) error {
+	if err := validateRFC3339(s); err != nil {
+		return fmt.Errorf("ValidateRFC3339: %w", err)
+	}
+	return nil
+}
+
+func validateRFC3339(s string) error {
+	p := parser{s: s}
+	y := p.digits(4)
+	p.expect('-')
+	mo := p.digits(2)
+	p.expect('-')
+	d := p.digits(2)
+	if c := p.next(); c != 'T' && c != 't' && p.err == nil {
+		p.fail("expected date/time separator 'T'")
+	}
+	h := p.digits(2)
+	p.expect(':')
+	mi := p.digits(2)
+	p.expect(':')
+	sec := p.digits(2)
+	if p.peek() == '.' {
+		p.next()
+		if c := p.peek(); c < '0' || c > '9' {
+			p.fail("expected fractional digits")
+		}
+		for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+			p.next()
+		}
+	}
+	var offset int64
+	switch c := p.next(); c {
+	case 'Z', 'z':
+	case '+', '-':
+		oh := p.digits(2)
+		p.expect(':')
+		om := p.digits(2)
+		if p.err == nil && (oh > 23 || om > 59) {
+			return fmt.Errorf("offset %02d:%02d out of range", oh, om)
+		}
+		offset = int64(oh)*Hour + int64(om)*Minute
+		if c == '-' {
+			offset = -offset
+		}
+	default:
+		if p.err == nil {
+			p.fail("expected 'Z' or a numeric offset")
+		}
+	}
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return p.err
+	}
+	if err := validCivil(y, mo, d, h, mi, sec); err != nil {
+		return err
+	}
+	// the UNIX time of the second, or for a leap second that of the end of
+	// the UTC day
+	unix := unixFromCivil(y, mo, d, h, mi, sec) - offset
+	if sec == 60 {
+		if !possibleLeapSecond(unix) {
+			return errors.New("leap second at a time without one")
+		}
+		return nil
+	}
+	if inSkippedSecond(unix) {
+		return errors.New("time omitted by a negative leap second")
+	}
+	return nil
+}
+
+// possibleLeapSecond returns true if a positive leap second may end at the
+// UNIX time midnight: it is in the table, or the table has expired by then and
+// midnight begins a month
+func possibleLeapSecond(midnight int64) bool {
+	if step, ok := LeapSecondAt(midnight); ok {
+		return step > 0
+	}
+	expires := atomic.LoadInt64(&leapExpires)
+	if expires == 0 || midnight <= expires {
+		return false
+	}
+	_, _, d, h, mi, s := civilFromUnix(midnight)
+	return d == 1 && h == 0 && mi == 0 && s == 0
+}
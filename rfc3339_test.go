@@ -0,0 +1,80 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestValidateRFC3339(t *testing.T) {
+	cases := []struct {
+		descr string
+		in    string
+		valid bool
+	}{
+		{"UTC", "2021-09-03T22:03:56Z", true},
+		{"Fraction", "2021-09-03T22:03:56.991894123456789123Z", true},
+		{"Offset", "2021-09-03T22:03:56.5+02:00", true},
+		{"LowerCase", "2021-09-03t22:03:56z", true},
+		{"UnknownOffset", "2021-09-03T22:03:56-00:00", true},
+		{"LeapDay", "2024-02-29T00:00:00Z", true},
+		{"LeapSecond", "2016-12-31T23:59:60Z", true},
+		{"LeapSecondOffset", "2016-12-31T15:59:60-08:00", true},
+		{"LeapSecondAfterExpiry", "9999-12-31T23:59:60Z", true},
+		{"Empty", "", false},
+		{"ShortYear", "921-09-03T22:03:56Z", false},
+		{"Space", "2021-09-03 22:03:56Z", false},
+		{"NoOffset", "2021-09-03T22:03:56", false},
+		{"CompactOffset", "2021-09-03T22:03:56+0200", false},
+		{"EmptyFraction", "2021-09-03T22:03:56.Z", false},
+		{"Comma", "2021-09-03T22:03:56,5Z", false},
+		{"Trailing", "2021-09-03T22:03:56Zjunk", false},
+		{"BadMonth", "2021-13-03T22:03:56Z", false},
+		{"BadDay", "2023-02-29T22:03:56Z", false},
+		{"BadHour", "2021-09-03T24:00:00Z", false},
+		{"BadMinute", "2021-09-03T22:60:00Z", false},
+		{"BadSecond", "2021-09-03T22:03:61Z", false},
+		{"BadOffsetHour", "2021-09-03T22:03:56+24:00", false},
+		{"BadOffsetMinute", "2021-09-03T22:03:56+02:60", false},
+		{"LeapSecondWrongDay", "2016-06-30T23:59:60Z", false},
+		{"LeapSecondWrongMinute", "2016-12-31T23:58:60Z", false},
+		{"LeapSecondIgnoringOffset", "2016-12-31T23:59:60+01:00", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			err := tai.ValidateRFC3339(tc.in)
+			if tc.valid && err != nil {
+				t.Fatalf("expected %q to be valid, got %v", tc.in, err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatalf("expected %q to be invalid", tc.in)
+			}
+		})
+	}
+}
+
+func TestValidateRFC3339NegativeLeap(t *testing.T) {
+	withNegativeLeap(t, func() {
+		if err := tai.ValidateRFC3339("2099-12-31T23:59:59.5Z"); err == nil {
+			t.Fatal("expected the omitted second to be invalid")
+		}
+		if err := tai.ValidateRFC3339("2099-12-31T23:59:58Z"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestValidateRFC3339Allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		tai.ValidateRFC3339("2016-12-31T15:59:60.123-08:00")
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkValidateRFC3339(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		tai.ValidateRFC3339("2021-09-03T22:03:56.991894+02:00")
+	}
+}
@@ -0,0 +1,41 @@
+package tai
+
+// Rotation is a schedule of key rotation epochs: epoch k is in force over
+// [Origin + k*Period, Origin + (k+1)*Period).  A key is also accepted within
+// Grace of either end of its epoch, so that parties whose clocks disagree by
+// less than Grace always share an accepted key.
+//
+// a schedule on TAI is unaffected by leap seconds; every epoch is exactly
+// Period long.  Period must be positive.
+type Rotation struct {
+	Origin TAI
+	Period Duration
+	Grace  Duration
+}
+
+func (r Rotation) grid() Grid {
+	return Grid{Origin: r.Origin, Step: r.Period}
+}
+
+// Epoch returns the epoch in force at t
+func (r Rotation) Epoch(t TAI) int64 {
+	return r.grid().Index(t)
+}
+
+// Start returns the beginning of epoch k
+func (r Rotation) Start(k int64) TAI {
+	return r.grid().At(k)
+}
+
+// End returns the end of epoch k, which is the beginning of epoch k+1
+func (r Rotation) End(k int64) TAI {
+	return r.grid().At(k + 1)
+}
+
+// Accepted returns the range of epochs, first through last inclusive, whose
+// keys are accepted at t: the epoch in force, and its neighbors if t is within
+// Grace of their epochs
+func (r Rotation) Accepted(t TAI) (first, last int64) {
+	g := r.grid()
+	return g.Index(t.AddDuration(r.Grace.Neg())), g.Index(t.AddDuration(r.Grace))
+}
@@ -0,0 +1,40 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestRotation(t *testing.T) {
+	origin := tai.Date(2024, 1, 1)
+	r := tai.Rotation{Origin: origin, Period: tai.Dur(tai.Day, 0), Grace: tai.Dur(5*tai.Minute, 0)}
+	if k := r.Epoch(origin.Add(3*tai.Day+7, 0)); k != 3 {
+		t.Errorf("expected epoch 3, got %d", k)
+	}
+	if k := r.Epoch(origin.Add(0, -1)); k != -1 {
+		t.Errorf("expected epoch -1, got %d", k)
+	}
+	if got, exp := r.End(2), r.Start(3); !got.Eq(exp) || !exp.Eq(origin.Add(3*tai.Day, 0)) {
+		t.Errorf("expected end of epoch 2 at %+v, got %+v", exp, got)
+	}
+	cases := []struct {
+		descr       string
+		t           tai.TAI
+		first, last int64
+	}{
+		{"Middle", origin.Add(tai.Day/2, 0), 0, 0},
+		{"AfterRotation", origin.Add(tai.Day+tai.Minute, 0), 0, 1},
+		{"BeforeRotation", origin.Add(tai.Day-tai.Minute, 0), 0, 1},
+		{"GraceEnded", origin.Add(tai.Day+5*tai.Minute, 0), 1, 1},
+		{"GraceNotBegun", origin.Add(tai.Day-5*tai.Minute, -1), 0, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			first, last := r.Accepted(tc.t)
+			if first != tc.first || last != tc.last {
+				t.Fatalf("expected epochs %d-%d, got %d-%d", tc.first, tc.last, first, last)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package tai
+
+import (
+	"fmt"
+	"strings"
+)
+
+// roundTripSamples are the instants formatted and parsed by
+// CheckLayoutRoundTrip.  They vary every field of Gregorian, including from
+// morning to afternoon and between centuries.
+var roundTripSamples = []TAI{
+	Date(2024, 2, 29).AddHMS(13, 4, 5).Add(0, 123456789012345678),
+	Date(1999, 12, 31).AddHMS(23, 59, 59).Add(0, 987654321098765432),
+	Date(1900, 7, 15).AddHMS(9, 30, 45).Add(0, 5e17),
+	Date(2100, 10, 10).AddHMS(20, 10, 20).Add(0, 1),
+	Date(1000, 1, 1).AddHMS(1, 1, 1),
+	Date(9999, 12, 31).AddHMS(11, 58, 30).Add(0, 999999999999999999),
+}
+
+// CheckLayoutRoundTrip returns an error if layout does not identify an instant
+// uniquely, so that Parse(layout, t.Format(layout)) is t to the precision of
+// its fractional second for every t in the years 1000 through 9999, which %Y
+// formats with four digits.  Layouts that lose a field, such as "%H:%M", which
+// has no date, or "%y", whose century is implied, are rejected, as are invalid
+// layouts.  Services may use it to validate a layout supplied by an operator.
+//
+// precision finer than a second is not required: RFC3339 round trips to the
+// second, and RFC3339Nano to the nanosecond.
+func CheckLayoutRoundTrip(layout string) error {
+	if err := checkLayoutRoundTrip(layout); err != nil {
+		return fmt.Errorf("CheckLayoutRoundTrip: %w", err)
+	}
+	return nil
+}
+
+func checkLayoutRoundTrip(layout string) error {
+	if err := checkLayout(layout); err != nil {
+		return err
+	}
+	width := layoutPrecision(layout)
+	for _, t := range roundTripSamples {
+		exp := t
+		exp.asec -= exp.asec % pow10[18-width]
+		s := t.Format(layout)
+		got, err := Parse(layout, s)
+		if err != nil {
+			return fmt.Errorf("layout %q does not parse its own output: %w", layout, err)
+		}
+		if !got.Eq(exp) {
+			return fmt.Errorf("layout %q loses the %s: %s formats as %q, which parses as %s",
+				layout, lostField(exp.AsGregorian(), got.AsGregorian()), exp.Format(RFC3339Trimmed), s, got.Format(RFC3339Trimmed))
+		}
+	}
+	return nil
+}
+
+// layoutPrecision returns the number of fractional digits of layout, the
+// widest of its %f, %F, and %N specifiers
+func layoutPrecision(layout string) int {
+	width := 0
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' || i+1 == len(layout) {
+			continue
+		}
+		i++
+		w := 0
+		switch c := layout[i]; {
+		case c == 'f':
+			w = 6
+		case c == 'F':
+			w = 9
+		case c == 'N' || c == '-' || (c >= '0' && c <= '9'):
+			var ok bool
+			w, _, i, ok = parseFracSpec(layout, i)
+			if !ok {
+				w = 0
+			}
+		}
+		if w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// lostField names the fields in which got differs from exp
+func lostField(exp, got Gregorian) string {
+	var lost []string
+	for _, f := range []struct {
+		name string
+		diff bool
+	}{
+		{"year", exp.Year != got.Year},
+		{"month", exp.Month != got.Month},
+		{"day", exp.Day != got.Day},
+		{"hour", exp.Hour != got.Hour},
+		{"minute", exp.Min != got.Min},
+		{"second", exp.Sec != got.Sec},
+		{"fractional second", exp.Asec != got.Asec},
+	} {
+		if f.diff {
+			lost = append(lost, f.name)
+		}
+	}
+	return strings.Join(lost, " and ")
+}
@@ -0,0 +1,42 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestCheckLayoutRoundTrip(t *testing.T) {
+	cases := []struct {
+		descr  string
+		layout string
+		ok     bool
+	}{
+		{"RFC3339", tai.RFC3339, true},
+		{"RFC3339Nano", tai.RFC3339Nano, true},
+		{"RFC3339Atto", tai.RFC3339Atto, true},
+		{"RFC3339Trimmed", tai.RFC3339Trimmed, true},
+		{"FIX", tai.FIX, true},
+		{"Compact", "%Y%m%d%H%M%S", true},
+		{"OrdinalDate", "%Y-%j %H:%M:%S", true},
+		{"Names", "%A, %o %B %EY %I:%M:%S %p", true},
+		{"TimeOnly", "%H:%M", false},
+		{"NoSeconds", "%Y-%m-%d %H:%M", false},
+		{"ShortYear", "%y-%m-%d %H:%M:%S", false},
+		{"NoMeridiem", "%Y-%m-%d %I:%M:%S", false},
+		{"Syslog", tai.Syslog, false},
+		{"WeekdayOnly", "%a %H:%M:%S", false},
+		{"Invalid", "%Q", false},
+	}
+	for _, c := range cases {
+		t.Run(c.descr, func(t *testing.T) {
+			err := tai.CheckLayoutRoundTrip(c.layout)
+			if c.ok && err != nil {
+				t.Fatal(err)
+			}
+			if !c.ok && err == nil {
+				t.Fatalf("expected %q to be rejected", c.layout)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Frequency is the base period of a Recurrence, the FREQ rule part of RFC 5545
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+var (
+	frequencyNames = [...]string{"", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+	rruleDayCodes  = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+	// cycleLength is the number of periods of each frequency in one 400 year
+	// cycle of the Gregorian calendar, after which any rule repeats
+	cycleLength = [...]int{0, eraDays, eraDays / 7, eraYears * 12, eraYears}
+)
+
+// String returns the RFC 5545 name of f, e.g. WEEKLY
+func (f Frequency) String() string {
+	if f < Daily || f > Yearly {
+		return fmt.Sprintf("Frequency(%d)", int(f))
+	}
+	return frequencyNames[f]
+}
+
+// WeekdayNum is an entry of the BYDAY rule part of RFC 5545.
+//
+// N selects the Nth such weekday within the month (Monthly) or year (Yearly),
+// counting from the end if negative; e.g. {N: -1, Weekday: Friday} is the last
+// Friday.  N of zero matches every such weekday.
+type WeekdayNum struct {
+	N       int
+	Weekday Weekday
+}
+
+// Recurrence is a recurrence rule implementing a subset of RFC 5545: the FREQ,
+// INTERVAL, BYDAY, BYMONTHDAY, COUNT, and UNTIL rule parts.
+//
+// The rule is evaluated in the TAI calendar (see func AsGregorian), weeks begin
+// on Monday, and every occurrence has the same time of day as Start.  Only
+// occurrences at or after Start are produced; Start itself is an occurrence
+// only if it matches the rule.
+type Recurrence struct {
+	// Start is the first moment of the recurrence (DTSTART)
+	Start TAI
+	// Freq is the base period of the recurrence
+	Freq Frequency
+	// Interval is the number of periods between each set of occurrences;
+	// zero is treated as 1
+	Interval int
+	// ByDay restricts or expands the occurrences to the given weekdays
+	ByDay []WeekdayNum
+	// ByMonthDay restricts or expands the occurrences to the given days of the
+	// month, counting from the end if negative
+	ByMonthDay []int
+	// Count is the maximum number of occurrences; zero is unlimited
+	Count int
+	// Until is the last moment an occurrence may happen; the zero value
+	// imposes no limit
+	Until TAI
+}
+
+// Each calls fn for each occurrence of r in chronological order, until fn
+// returns false or the recurrence is exhausted.
+//
+// a rule without Count or Until is infinite, and Each will not return until
+// fn returns false.  If no occurrence is found within a 400 year cycle of the
+// calendar, the rule can never match and Each returns.
+func (r Recurrence) Each(fn func(TAI) bool) {
+	if r.Freq < Daily || r.Freq > Yearly {
+		return
+	}
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	startDay, tod := floorDiv(r.Start.sec, Day)
+	g := r.Start.AsGregorian()
+	hasUntil := !r.Until.IsZero()
+	n := 0
+	empty := 0
+	for k := 0; ; k += interval {
+		lo, hi := r.period(k, startDay, g)
+		matched := false
+		for d := lo; d < hi; d++ {
+			if d < startDay || !r.matches(d, lo, hi, startDay, g) {
+				continue
+			}
+			matched = true
+			ta := Tai(SecsEpochFromDays64(d)+tod, r.Start.asec)
+			if hasUntil && ta.After(r.Until) {
+				return
+			}
+			if !fn(ta) {
+				return
+			}
+			n++
+			if r.Count > 0 && n >= r.Count {
+				return
+			}
+		}
+		if matched {
+			empty = 0
+		} else if empty++; empty > cycleLength[r.Freq] {
+			return
+		}
+	}
+}
+
+// period returns the range of days [lo, hi) of the k-th period after the one
+// containing startDay
+func (r Recurrence) period(k int, startDay int64, g Gregorian) (lo, hi int64) {
+	switch r.Freq {
+	case Daily:
+		return startDay + int64(k), startDay + int64(k) + 1
+	case Weekly:
+		monday := startDay - int64(weekdayFromDays64(startDay)+6)%7
+		lo = monday + 7*int64(k)
+		return lo, lo + 7
+	case Monthly:
+		y64, m64 := floorDiv(int64(g.Year*12+int(g.Month)-1+k), 12)
+		y, m := int(y64), int(m64)+1
+		lo = DaysFromCivil64(y, m, 1)
+		return lo, lo + int64(DaysInMonth(m, y))
+	default: // Yearly
+		y := g.Year + k
+		return DaysFromCivil64(y, 1, 1), DaysFromCivil64(y+1, 1, 1)
+	}
+}
+
+// matches returns true if day d of the period [lo, hi) is an occurrence
+func (r Recurrence) matches(d, lo, hi, startDay int64, g Gregorian) bool {
+	y, m, md := CivilFromDays64(d)
+	if len(r.ByMonthDay) > 0 {
+		dim := DaysInMonth(m, y)
+		ok := false
+		for _, v := range r.ByMonthDay {
+			if v == md || v == md-dim-1 {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 {
+		wd := Weekday(weekdayFromDays64(d))
+		nth := int((d-lo)/7) + 1
+		fromEnd := -(int((hi-1-d)/7) + 1)
+		ok := false
+		for _, v := range r.ByDay {
+			if v.Weekday == wd && (v.N == 0 || v.N == nth || v.N == fromEnd) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(r.ByMonthDay) > 0 || len(r.ByDay) > 0 {
+		return true
+	}
+	// with neither BYDAY nor BYMONTHDAY, the missing rule parts come from Start
+	switch r.Freq {
+	case Weekly:
+		return weekdayFromDays64(d) == weekdayFromDays64(startDay)
+	case Monthly:
+		return md == g.Day
+	case Yearly:
+		return Month(m) == g.Month && md == g.Day
+	}
+	return true
+}
+
+// Next returns the first occurrence of r after t, and false if there is none
+func (r Recurrence) Next(t TAI) (TAI, bool) {
+	var (
+		next TAI
+		ok   bool
+	)
+	r.Each(func(o TAI) bool {
+		if o.After(t) {
+			next, ok = o, true
+			return false
+		}
+		return true
+	})
+	return next, ok
+}
+
+// Between returns the occurrences of r in the closed interval [start, end]
+func (r Recurrence) Between(start, end TAI) []TAI {
+	var out []TAI
+	r.Each(func(o TAI) bool {
+		if o.After(end) {
+			return false
+		}
+		if !o.Before(start) {
+			out = append(out, o)
+		}
+		return true
+	})
+	return out
+}
+
+// ParseRRule parses the value of an RFC 5545 RRULE property, such as
+// "FREQ=MONTHLY;BYDAY=-1FR;COUNT=12", with the given start (DTSTART).  A
+// leading "RRULE:" is permitted.
+//
+// rule parts outside of the subset supported by Recurrence produce an error.
+//
+// UNTIL may be a DATE, which includes the whole of that day, or a DATE-TIME.
+// A DATE-TIME in UTC ("Z" suffix) is converted to TAI using the leap second
+// table, and a floating DATE-TIME is interpreted in the TAI calendar.
+func ParseRRule(s string, start TAI) (Recurrence, error) {
+	r := Recurrence{Start: start}
+	s = strings.TrimPrefix(s, "RRULE:")
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return Recurrence{}, fmt.Errorf("ParseRRule: malformed rule part %q", part)
+		}
+		key, val := strings.ToUpper(kv[0]), kv[1]
+		if seen[key] {
+			return Recurrence{}, fmt.Errorf("ParseRRule: duplicate rule part %s", key)
+		}
+		seen[key] = true
+		var err error
+		switch key {
+		case "FREQ":
+			err = errors.New("unsupported frequency " + val)
+			for f := Daily; f <= Yearly; f++ {
+				if strings.EqualFold(val, frequencyNames[f]) {
+					r.Freq, err = f, nil
+				}
+			}
+		case "INTERVAL":
+			r.Interval, err = parsePositive(val)
+		case "COUNT":
+			r.Count, err = parsePositive(val)
+		case "UNTIL":
+			r.Until, err = parseRRuleUntil(val)
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(val, ",") {
+				var md int
+				md, err = strconv.Atoi(v)
+				if err != nil || md == 0 || md < -31 || md > 31 {
+					err = fmt.Errorf("invalid BYMONTHDAY %q", v)
+					break
+				}
+				r.ByMonthDay = append(r.ByMonthDay, md)
+			}
+		case "BYDAY":
+			for _, v := range strings.Split(val, ",") {
+				var wn WeekdayNum
+				wn, err = parseWeekdayNum(v)
+				if err != nil {
+					break
+				}
+				r.ByDay = append(r.ByDay, wn)
+			}
+		case "WKST":
+			if !strings.EqualFold(val, "MO") {
+				err = errors.New("only WKST=MO is supported")
+			}
+		default:
+			err = errors.New("unsupported rule part " + key)
+		}
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("ParseRRule: %w", err)
+		}
+	}
+	if r.Freq == 0 {
+		return Recurrence{}, errors.New("ParseRRule: FREQ is required")
+	}
+	if seen["COUNT"] && seen["UNTIL"] {
+		return Recurrence{}, errors.New("ParseRRule: COUNT and UNTIL are mutually exclusive")
+	}
+	if r.Freq == Daily || r.Freq == Weekly {
+		for _, v := range r.ByDay {
+			if v.N != 0 {
+				return Recurrence{}, fmt.Errorf("ParseRRule: BYDAY ordinals are not valid with FREQ=%s", r.Freq)
+			}
+		}
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return Recurrence{}, errors.New("ParseRRule: BYMONTHDAY is not valid with FREQ=WEEKLY")
+	}
+	return r, nil
+}
+
+func parsePositive(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 1 {
+		return 0, fmt.Errorf("expected a positive integer, got %q", s)
+	}
+	return v, nil
+}
+
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	if len(s) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", s)
+	}
+	code := strings.ToUpper(s[len(s)-2:])
+	wn := WeekdayNum{Weekday: -1}
+	for i, c := range rruleDayCodes {
+		if c == code {
+			wn.Weekday = Weekday(i)
+		}
+	}
+	if wn.Weekday < 0 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY weekday %q", s)
+	}
+	if ord := s[:len(s)-2]; ord != "" {
+		n, err := strconv.Atoi(ord)
+		if err != nil || n == 0 || n < -53 || n > 53 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY ordinal %q", s)
+		}
+		wn.N = n
+	}
+	return wn, nil
+}
+
+func parseRRuleUntil(s string) (TAI, error) {
+	p := parser{s: s}
+	y := p.digits(4)
+	m := p.digits(2)
+	d := p.digits(2)
+	if p.err == nil && p.i == len(s) {
+		if err := validCivil(y, m, d, 0, 0, 0); err != nil {
+			return TAI{}, err
+		}
+		// a DATE includes the entirety of that day
+		return Tai(SecsEpochFromDays64(DaysFromCivil64(y, m, d))+Day, -1), nil
+	}
+	t, err := parseICalDateTime(s, FloatingTAI)
+	if err != nil {
+		return TAI{}, fmt.Errorf("invalid UNTIL: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,109 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func occurrenceDates(ts []tai.TAI) [][3]int {
+	out := make([][3]int, len(ts))
+	for i, ta := range ts {
+		g := ta.AsGregorian()
+		out[i] = [3]int{g.Year, int(g.Month), g.Day}
+	}
+	return out
+}
+
+func TestRecurrenceRules(t *testing.T) {
+	// Monday, July 1, 2024 at 09:30
+	start := tai.Date(2024, 7, 1).AddHMS(9, 30, 0)
+	cases := []struct {
+		descr string
+		rule  string
+		exp   [][3]int
+	}{
+		{"DailyCount", "FREQ=DAILY;COUNT=3", [][3]int{{2024, 7, 1}, {2024, 7, 2}, {2024, 7, 3}}},
+		{"DailyInterval", "FREQ=DAILY;INTERVAL=10;COUNT=3", [][3]int{{2024, 7, 1}, {2024, 7, 11}, {2024, 7, 21}}},
+		{"WeeklyByDay", "FREQ=WEEKLY;BYDAY=MO,FR;COUNT=4", [][3]int{{2024, 7, 1}, {2024, 7, 5}, {2024, 7, 8}, {2024, 7, 12}}},
+		{"BiweeklyUntil", "FREQ=WEEKLY;INTERVAL=2;UNTIL=20240729", [][3]int{{2024, 7, 1}, {2024, 7, 15}, {2024, 7, 29}}},
+		{"MonthlyLastFriday", "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", [][3]int{{2024, 7, 26}, {2024, 8, 30}, {2024, 9, 27}}},
+		{"MonthlyLastDay", "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", [][3]int{{2024, 7, 31}, {2024, 8, 31}, {2024, 9, 30}}},
+		{"Friday13th", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=2", [][3]int{{2024, 9, 13}, {2024, 12, 13}}},
+		{"MonthlySkipsShortMonths", "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=3", [][3]int{{2024, 7, 31}, {2024, 8, 31}, {2024, 10, 31}}},
+		{"YearlyDefault", "FREQ=YEARLY;COUNT=2", [][3]int{{2024, 7, 1}, {2025, 7, 1}}},
+		{"YearlyFirstMonday", "RRULE:FREQ=YEARLY;BYDAY=1MO;COUNT=2", [][3]int{{2025, 1, 6}, {2026, 1, 5}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			r, err := tai.ParseRRule(tc.rule, start)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []tai.TAI
+			r.Each(func(ta tai.TAI) bool {
+				got = append(got, ta)
+				return len(got) < 100
+			})
+			dates := occurrenceDates(got)
+			if len(dates) != len(tc.exp) {
+				t.Fatalf("expected %v, got %v", tc.exp, dates)
+			}
+			for i := range dates {
+				if dates[i] != tc.exp[i] {
+					t.Fatalf("expected %v, got %v", tc.exp, dates)
+				}
+				if g := got[i].AsGregorian(); g.Hour != 9 || g.Min != 30 {
+					t.Fatalf("occurrence %d lost the time of day, got %+v", i, g)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	start := tai.Date(2024, 1, 1)
+	r := tai.Recurrence{Start: start, Freq: tai.Monthly, ByMonthDay: []int{15}}
+	next, ok := r.Next(tai.Date(2024, 3, 15))
+	if !ok {
+		t.Fatal("expected an occurrence")
+	}
+	if !next.Eq(tai.Date(2024, 4, 15)) {
+		t.Fatalf("expected April 15, got %+v", next.AsGregorian())
+	}
+}
+
+func TestRecurrenceNeverMatches(t *testing.T) {
+	r := tai.Recurrence{Start: tai.Date(2024, 1, 1), Freq: tai.Yearly, ByMonthDay: []int{31}, ByDay: []tai.WeekdayNum{{N: 60, Weekday: 1}}}
+	if _, ok := r.Next(r.Start); ok {
+		t.Fatal("expected no occurrence for an impossible rule")
+	}
+}
+
+func TestRecurrenceBetween(t *testing.T) {
+	r := tai.Recurrence{Start: tai.Date(2024, 1, 1), Freq: tai.Daily}
+	got := r.Between(tai.Date(2024, 2, 27), tai.Date(2024, 3, 1))
+	if len(got) != 4 {
+		t.Fatalf("expected 4 occurrences over a leap day, got %v", occurrenceDates(got))
+	}
+}
+
+func TestParseRRuleInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20240101",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=YEARLY;BYMONTH=1",
+		"FREQ=DAILY;FREQ=WEEKLY",
+	}
+	for _, inp := range cases {
+		if _, err := tai.ParseRRule(inp, tai.TAI{}); err == nil {
+			t.Errorf("expected error parsing %q", inp)
+		}
+	}
+}
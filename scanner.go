@@ -0,0 +1,149 @@
+package tai
+
+import (
+	"fmt"
+	"io"
+)
+
+// Scanner extracts the timestamps of a layout from a stream of text, such as
+// a log or telemetry file, without reading it into memory.  Timestamps may be
+// anywhere in the stream, including directly after one another.  Use it as a
+// bufio.Scanner:
+//
+//	sc := tai.NewScanner(f, tai.RFC3339Nano)
+//	for sc.Scan() {
+//		index[sc.Time()] = sc.Offset()
+//	}
+//	if err := sc.Err(); err != nil {
+//		...
+//	}
+//
+// the stream is searched from the start for the leftmost text that parses
+// with the layout, as by func Parse, and the search resumes after it.  Text
+// that matches the layout but not a valid date, e.g. 2024-13-01, is skipped.
+type Scanner struct {
+	r      io.Reader
+	layout string
+	loc    *Locale
+	window int
+
+	buf   []byte
+	start int   // index of the first unsearched byte of buf
+	base  int64 // offset in the stream of buf[0]
+	eof   bool
+	rerr  error // a read error, reported once buf is exhausted
+	err   error
+
+	t   TAI
+	off int64
+	tok []byte
+}
+
+// NewScanner returns a Scanner for the timestamps of layout in r; an empty
+// layout is Config.Layout.  Names are those of the configured Locale.
+func NewScanner(r io.Reader, layout string) *Scanner {
+	layout = layoutOr(layout)
+	s := &Scanner{r: r, layout: layout, loc: currentConfig().locale(), window: 64 + 8*len(layout)}
+	if err := checkLayout(layout); err != nil {
+		s.err = fmt.Errorf("NewScanner: %w", err)
+	}
+	return s
+}
+
+// Scan advances to the next timestamp, and returns false when there are no
+// more or an error occurred
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	for {
+		// a match must have the window in the buffer, unless the stream has
+		// ended, so that it is not cut short
+		for ; s.start < len(s.buf) && (s.eof || len(s.buf)-s.start >= s.window); s.start++ {
+			p := parser{s: bytesString(s.buf[s.start:]), quiet: true}
+			t, err := parseWith(&p, s.layout, s.loc, false)
+			if err != nil || p.i == 0 {
+				continue
+			}
+			s.t, s.off, s.tok = t, s.base+int64(s.start), s.buf[s.start:s.start+p.i]
+			s.start += p.i
+			return true
+		}
+		if s.eof {
+			s.err = s.rerr
+			return false
+		}
+		s.fill()
+	}
+}
+
+// fill discards the searched part of the buffer and reads more of the stream
+func (s *Scanner) fill() {
+	n := copy(s.buf, s.buf[s.start:])
+	s.buf = s.buf[:n]
+	s.base += int64(s.start)
+	s.start = 0
+	if cap(s.buf) < 2*s.window {
+		size := 4096
+		if size < 2*s.window {
+			size = 2 * s.window
+		}
+		buf := make([]byte, n, size)
+		copy(buf, s.buf)
+		s.buf = buf
+	}
+	// read only as much as is needed to search, so that a live stream is
+	// scanned as it is written
+	for empty := 0; len(s.buf) < s.window; {
+		k, err := s.r.Read(s.buf[len(s.buf):cap(s.buf)])
+		s.buf = s.buf[:len(s.buf)+k]
+		if err != nil {
+			s.eof = true
+			if err != io.EOF {
+				s.rerr = err
+			}
+			return
+		}
+		if k > 0 {
+			continue
+		}
+		if empty++; empty == 100 {
+			s.eof, s.rerr = true, io.ErrNoProgress
+			return
+		}
+	}
+}
+
+// Time returns the timestamp found by the last call to Scan
+func (s *Scanner) Time() TAI {
+	return s.t
+}
+
+// Offset returns the byte offset in the stream of the start of the timestamp
+// found by the last call to Scan
+func (s *Scanner) Offset() int64 {
+	return s.off
+}
+
+// Bytes returns the text of the timestamp found by the last call to Scan.  It
+// may be overwritten by the next call to Scan.
+func (s *Scanner) Bytes() []byte {
+	return s.tok
+}
+
+// Err returns the first error encountered by the Scanner, other than io.EOF
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// checkLayout returns an error if layout contains a specifier unknown to
+// Format and Parse
+func checkLayout(layout string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid layout %q", layout)
+		}
+	}()
+	FormatGregorian(Gregorian{Year: 2000, Month: January, Day: 1}, layout)
+	return nil
+}
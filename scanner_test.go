@@ -0,0 +1,79 @@
+package tai_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/brandondube/tai"
+)
+
+func TestScanner(t *testing.T) {
+	a := tai.Date(2024, 9, 3).AddHMS(15, 4, 5)
+	b := a.Add(1, 250*tai.Millisecond)
+	c := tai.Date(2025, 1, 1)
+	in := "boot " + a.Format(tai.RFC3339Milli) + " ok\n" + b.Format(tai.RFC3339Milli) + c.Format(tai.RFC3339Milli) + "\n2024-13-01T00:00:00.000Z tail"
+	exp := []struct {
+		t   tai.TAI
+		off int64
+	}{{a, 5}, {b, 33}, {c, 57}}
+	readers := map[string]func() io.Reader{
+		"Whole":   func() io.Reader { return strings.NewReader(in) },
+		"OneByte": func() io.Reader { return iotest.OneByteReader(strings.NewReader(in)) },
+		"Long":    func() io.Reader { return strings.NewReader(strings.Repeat(" ", 10000) + in) },
+	}
+	for descr, r := range readers {
+		t.Run(descr, func(t *testing.T) {
+			var pad int64
+			if descr == "Long" {
+				pad = 10000
+			}
+			sc := tai.NewScanner(r(), tai.RFC3339Milli)
+			var n int
+			for ; sc.Scan(); n++ {
+				if n >= len(exp) {
+					t.Fatalf("unexpected timestamp %q", sc.Bytes())
+				}
+				if !sc.Time().Eq(exp[n].t) || sc.Offset() != exp[n].off+pad {
+					t.Fatalf("timestamp %d: expected %+v at %d, got %+v at %d", n, exp[n].t, exp[n].off+pad, sc.Time(), sc.Offset())
+				}
+				if s := string(sc.Bytes()); s != exp[n].t.Format(tai.RFC3339Milli) {
+					t.Fatalf("timestamp %d: unexpected text %q", n, s)
+				}
+			}
+			if err := sc.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if n != len(exp) {
+				t.Fatalf("expected %d timestamps, got %d", len(exp), n)
+			}
+		})
+	}
+}
+
+func TestScannerInvalidLayout(t *testing.T) {
+	sc := tai.NewScanner(strings.NewReader("2024"), "%Q")
+	if sc.Scan() {
+		t.Fatal("expected no timestamps")
+	}
+	if sc.Err() == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestScannerReadError(t *testing.T) {
+	errBroken := errors.New("broken")
+	r := io.MultiReader(strings.NewReader("at 2024-09-03T15:04:05Z"), iotest.ErrReader(errBroken))
+	sc := tai.NewScanner(r, tai.RFC3339)
+	if !sc.Scan() {
+		t.Fatalf("expected the timestamp before the error, got %v", sc.Err())
+	}
+	if sc.Scan() {
+		t.Fatal("expected no more timestamps")
+	}
+	if !errors.Is(sc.Err(), errBroken) {
+		t.Fatalf("expected the read error, got %v", sc.Err())
+	}
+}
@@ -0,0 +1,61 @@
+package tai
+
+// smearHalf is half of the length of a linear smear
+const smearHalf = 12 * Hour
+
+// smearFromUTC returns the TAI time of the smeared UTC time secs+asec, and
+// false if it is not within a smear
+func smearFromUTC(secs, asec int64) (TAI, bool) {
+	leaplock.RLock()
+	defer leaplock.RUnlock()
+	return smearFromUTCIn(leaps, secs, asec)
+}
+
+// smearFromUTCIn is smearFromUTC for an arbitrary table
+func smearFromUTCIn(leaps []leap, secs, asec int64) (TAI, bool) {
+	// the first entry is the initial offset of 1972, not a leap second
+	for i := len(leaps) - 1; i >= 1; i-- {
+		start := leaps[i].UnixUTC - smearHalf
+		if secs >= start+2*smearHalf {
+			return TAI{}, false
+		}
+		if secs >= start {
+			old := leaps[i-1].CumulativeSkew
+			step := leaps[i].CumulativeSkew - old
+			// the offset grows from old to new over the smear
+			offset := Dur(secs-start, asec).Mul(step).div(2 * smearHalf)
+			return Tai(secs+unixEpochSkew+old, asec).AddDuration(offset), true
+		}
+	}
+	return TAI{}, false
+}
+
+// smearToUTC returns the smeared UTC time of t, and false if it is not within
+// a smear
+func smearToUTC(t TAI) (secs, asec int64, ok bool) {
+	leaplock.RLock()
+	defer leaplock.RUnlock()
+	return smearToUTCIn(leaps, t)
+}
+
+// smearToUTCIn is smearToUTC for an arbitrary table
+func smearToUTCIn(leaps []leap, t TAI) (secs, asec int64, ok bool) {
+	s := t.sec - unixEpochSkew
+	for i := len(leaps) - 1; i >= 1; i-- {
+		old := leaps[i-1].CumulativeSkew
+		step := leaps[i].CumulativeSkew - old
+		start := leaps[i].UnixUTC - smearHalf
+		if s >= start+2*smearHalf+leaps[i].CumulativeSkew {
+			return 0, 0, false
+		}
+		if s >= start+old {
+			// 2*smearHalf+step seconds of TAI elapse over the smear.  The
+			// quotient is rounded up, so that the floor of smearFromUTC is
+			// inverted exactly for whole nanoseconds
+			d := Dur(s-start-old, t.asec).Mul(2 * smearHalf).Neg().div(2*smearHalf + step).Neg()
+			secs, asec = Dur(start, 0).Add(d).Parts()
+			return secs, asec, true
+		}
+	}
+	return 0, 0, false
+}
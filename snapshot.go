@@ -0,0 +1,159 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Converter converts between TAI and UTC with the leap second table and
+// Config captured by func Snapshot.  It is immutable: a later change to the
+// table (e.g. by RegisterLeapSecond or a leap-seconds.list update) or to the
+// configuration does not affect it, so a batch of conversions made with one
+// Converter is reproducible.  A Converter is safe for concurrent use.
+type Converter struct {
+	leaps  []leap
+	config Config
+
+	hashOnce sync.Once
+	hash     string
+}
+
+// Snapshot returns a Converter with the current leap second table and Config
+func Snapshot() *Converter {
+	leaplock.RLock()
+	table := append([]leap(nil), leaps...)
+	leaplock.RUnlock()
+	return &Converter{leaps: table, config: GetConfig()}
+}
+
+// NewConverter returns a Converter with the given leap second table and
+// configuration, independent of the global table and Config.  The table must
+// be in chronological order.  Zero fields of cfg take the defaults of Config,
+// and the Clock is not used.
+func NewConverter(table []LeapSecond, cfg Config) (*Converter, error) {
+	for i := 1; i < len(table); i++ {
+		if table[i].UnixUTC <= table[i-1].UnixUTC {
+			return nil, errors.New("NewConverter: leap table is not in chronological order")
+		}
+	}
+	if cfg.Layout == "" {
+		cfg.Layout = RFC3339Nano
+	}
+	if cfg.Locale == (Locale{}) {
+		cfg.Locale = English
+	}
+	for _, o := range []Option{WithSmear(cfg.Smear), WithLocale(cfg.Locale), WithClock(cfg.Clock)} {
+		if err := o(&cfg); err != nil {
+			return nil, fmt.Errorf("NewConverter: %w", err)
+		}
+	}
+	cfg.UpdateURLs = append([]string(nil), cfg.UpdateURLs...)
+	c := &Converter{leaps: make([]leap, len(table)), config: cfg}
+	for i, l := range table {
+		c.leaps[i] = leap(l)
+	}
+	return c, nil
+}
+
+// WithLeapSecond returns a copy of c with a leap second at the UNIX time
+// unixUTC, after which TAI-UTC is changed by step: 1 for a second inserted
+// before unixUTC, or -1 for the second before it omitted.  It rehearses a leap
+// second not (yet) in the table without changing the global table; unixUTC
+// must be after the last leap second of c.
+func (c *Converter) WithLeapSecond(unixUTC, step int64) (*Converter, error) {
+	if step != 1 && step != -1 {
+		return nil, fmt.Errorf("Converter.WithLeapSecond: step must be 1 or -1, got %d", step)
+	}
+	var last leap
+	if len(c.leaps) > 0 {
+		last = c.leaps[len(c.leaps)-1]
+	}
+	if unixUTC <= last.UnixUTC {
+		return nil, errors.New("Converter.WithLeapSecond: the leap second must be after the last of the table")
+	}
+	out := &Converter{leaps: make([]leap, len(c.leaps), len(c.leaps)+1), config: c.config}
+	copy(out.leaps, c.leaps)
+	out.leaps = append(out.leaps, leap{UnixUTC: unixUTC, CumulativeSkew: last.CumulativeSkew + step})
+	return out, nil
+}
+
+// Config returns the configuration captured by c
+func (c *Converter) Config() Config {
+	cfg := c.config
+	cfg.UpdateURLs = append([]string(nil), cfg.UpdateURLs...)
+	return cfg
+}
+
+// LeapSeconds returns a copy of the leap second table captured by c
+func (c *Converter) LeapSeconds() []LeapSecond {
+	out := make([]LeapSecond, len(c.leaps))
+	for i, l := range c.leaps {
+		out[i] = LeapSecond(l)
+	}
+	return out
+}
+
+// LeapTableHash returns the identifier of the leap second table captured by
+// c; see func HashLeapSeconds
+func (c *Converter) LeapTableHash() string {
+	c.hashOnce.Do(func() {
+		c.hash = HashLeapSeconds(c.LeapSeconds())
+	})
+	return c.hash
+}
+
+// FromUnix is func Unix with the table and configuration of c
+func (c *Converter) FromUnix(seconds, nsec int64) TAI {
+	return c.fromUnix(seconds, nsec*Nanosecond)
+}
+
+func (c *Converter) fromUnix(seconds, asec int64) TAI {
+	if c.config.Smear == SmearLinear {
+		if t, ok := smearFromUTCIn(c.leaps, seconds, asec); ok {
+			return t
+		}
+	}
+	skew, _ := skewLeaps(c.leaps, seconds, UTCToTAI)
+	return Tai(seconds+unixEpochSkew+skew, asec)
+}
+
+// AsUnix is func (TAI) Unix with the table and configuration of c
+func (c *Converter) AsUnix(t TAI) (secs, nsecs int64) {
+	secs, asecs := c.unix(t)
+	return secs, asecs / Nanosecond
+}
+
+func (c *Converter) unix(t TAI) (secs, asecs int64) {
+	if c.config.Smear == SmearLinear {
+		if secs, asecs, ok := smearToUTCIn(c.leaps, t); ok {
+			return secs, asecs
+		}
+	}
+	secs = t.sec - unixEpochSkew
+	skew, _ := skewLeaps(c.leaps, secs, TAIToUTC)
+	return secs - skew, t.asec
+}
+
+// FromTime is func FromTime with the table and configuration of c
+func (c *Converter) FromTime(t time.Time) TAI {
+	return c.FromUnix(t.Unix(), int64(t.Nanosecond()))
+}
+
+// AsTime is func (TAI) AsTime with the table and configuration of c
+func (c *Converter) AsTime(t TAI) time.Time {
+	s, ns := c.AsUnix(t)
+	return time.Unix(s, ns).UTC()
+}
+
+// Format is func (TAI) Format with the locale of c
+func (c *Converter) Format(t TAI, fmtspec string) string {
+	b := make([]byte, 0, len(fmtspec)+24)
+	return string(appendFormatLocale(b, t.AsGregorian(), fmtspec, &c.config.Locale))
+}
+
+// Parse is func Parse with the locale of c
+func (c *Converter) Parse(layout, s string) (TAI, error) {
+	return parseLocale(layout, s, &c.config.Locale)
+}
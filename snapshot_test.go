@@ -0,0 +1,95 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestSnapshotIsolatedFromLeapTable(t *testing.T) {
+	const future = 4102444800 // 2100-01-01
+	c := tai.Snapshot()
+	before := tai.Unix(future, 0)
+	if err := tai.RegisterLeapSecond(future, 38); err != nil {
+		t.Fatal(err)
+	}
+	defer tai.RemoveLeapSecond(future)
+	if after := tai.Unix(future, 0); after.Eq(before) {
+		t.Fatal("expected the registered leap second to change the global conversion")
+	}
+	got := c.FromUnix(future, 0)
+	if !got.Eq(before) {
+		t.Fatalf("expected the snapshot to convert as before, %+v, got %+v", before, got)
+	}
+	if s, ns := c.AsUnix(got); s != future || ns != 0 {
+		t.Fatalf("expected %d, got %d.%09d", int64(future), s, ns)
+	}
+	if n, exp := len(c.LeapSeconds()), len(tai.LeapSeconds())-1; n != exp {
+		t.Fatalf("expected %d entries in the snapshot, got %d", exp, n)
+	}
+}
+
+func TestSnapshotIsolatedFromConfig(t *testing.T) {
+	defer restoreConfig(t)
+	fr := tai.English
+	fr.Months[tai.July-1] = "juillet"
+	if err := tai.Configure(tai.WithSmear(tai.SmearLinear), tai.WithLocale(fr)); err != nil {
+		t.Fatal(err)
+	}
+	c := tai.Snapshot()
+	restoreConfig(t)
+
+	table := tai.LeapSeconds()
+	leap := table[len(table)-1].UnixUTC
+	tm := time.Unix(leap, 0)
+	if smeared, exact := c.FromTime(tm), tai.FromTime(tm); smeared.Eq(exact) {
+		t.Fatal("expected the snapshot to keep the smear policy")
+	}
+	if got := c.AsTime(c.FromTime(tm)); !got.Equal(tm) {
+		t.Fatalf("expected %v, got %v", tm, got)
+	}
+	ta := tai.Date(2024, 7, 14)
+	s := c.Format(ta, "%d %B %Y")
+	if s != "14 juillet 2024" {
+		t.Fatalf("expected the snapshot's locale, got %q", s)
+	}
+	back, err := c.Parse("%d %B %Y", s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !back.Eq(ta) {
+		t.Fatalf("expected %+v, got %+v", ta, back)
+	}
+	if c.Config().Smear != tai.SmearLinear || tai.GetConfig().Smear != tai.SmearNone {
+		t.Fatal("expected the snapshot's configuration to be independent of the current one")
+	}
+}
+
+func TestNewConverter(t *testing.T) {
+	table := []tai.LeapSecond{{UnixUTC: 63072000, CumulativeSkew: 10}, {UnixUTC: 78796800, CumulativeSkew: 11}}
+	c, err := tai.NewConverter(table, tai.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg := c.Config(); cfg.Layout != tai.RFC3339Nano || cfg.Locale != tai.English {
+		t.Fatalf("expected the default layout and locale, got %+v", cfg)
+	}
+	// the table ends in 1972, so its offset holds thereafter
+	if got, exp := c.FromUnix(1719837296, 0), tai.Unix(1719837296, 0).Add(-26, 0); !got.Eq(exp) {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+	if _, err := tai.NewConverter([]tai.LeapSecond{table[1], table[0]}, tai.Config{}); err == nil {
+		t.Fatal("expected an error for a table out of order")
+	}
+	leapt, err := c.WithLeapSecond(94694400, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(leapt.LeapSeconds()); n != 3 || len(c.LeapSeconds()) != 2 {
+		t.Fatal("expected WithLeapSecond to extend a copy of the table")
+	}
+	if _, err := c.WithLeapSecond(63072000, 1); err == nil {
+		t.Fatal("expected an error for a leap second before the end of the table")
+	}
+}
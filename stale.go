@@ -0,0 +1,108 @@
+package tai
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// staleArmed is 1 while there are OnStale callbacks which have not fired
+	// for the current table
+	staleArmed int32
+	staleMu    sync.Mutex
+	staleFuncs []*staleFunc
+)
+
+// staleFunc is a callback registered by OnStale, by pointer so that it can be
+// unregistered
+type staleFunc struct {
+	f func()
+}
+
+// LeapTableExpiry returns the moment at which the leap second table expires:
+// that of the leap-seconds.list it was loaded from, or PkgUpToDateUntil if
+// none gave one.  After it, a leap second not in the table may have occurred.
+func LeapTableExpiry() TAI {
+	// not by Unix, which would fire the OnStale callbacks
+	exp := tableExpiry()
+	leaplock.RLock()
+	skew, _ := skewLeaps(leaps, exp, UTCToTAI)
+	leaplock.RUnlock()
+	return Tai(exp+unixEpochSkew+skew, 0)
+}
+
+// tableExpiry is LeapTableExpiry as a UNIX time
+func tableExpiry() int64 {
+	if exp := atomic.LoadInt64(&leapExpires); exp != 0 {
+		return exp
+	}
+	return unixFromCivil(PkgUpToDateUntil.Year, int(PkgUpToDateUntil.Month), PkgUpToDateUntil.Day, 0, 0, 0)
+}
+
+// IsStale returns true if the leap second table has expired by now
+func IsStale(now TAI) bool {
+	return !now.Before(LeapTableExpiry())
+}
+
+// StaleBy returns the time since the leap second table expired, or zero if it
+// has not
+func StaleBy() Duration {
+	d := Now().Sub(LeapTableExpiry())
+	if d.Less(Duration{}) {
+		return Duration{}
+	}
+	return d
+}
+
+// OnStale registers f to be called the first time the global leap second
+// table is used to convert an instant at or after its expiry, such as by Unix
+// or AsTime, so that a service can report that its table needs updating.  f
+// is called once per table, in its own goroutine so that no lock of pkg tai is
+// held while it runs; loading a table with a later expiry, such as by
+// LoadLeapSeconds, rearms it.  The returned function unregisters f.
+//
+// the callbacks are not called for conversions by a Converter or LeapCache.
+func OnStale(f func()) (unregister func()) {
+	sf := &staleFunc{f}
+	staleMu.Lock()
+	defer staleMu.Unlock()
+	staleFuncs = append(staleFuncs, sf)
+	atomic.StoreInt32(&staleArmed, 1)
+	return func() {
+		staleMu.Lock()
+		defer staleMu.Unlock()
+		for i, other := range staleFuncs {
+			if other == sf {
+				// copied, so that a concurrent checkStale keeps its slice
+				staleFuncs = append(staleFuncs[:i:i], staleFuncs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// checkStale fires the OnStale callbacks if the UNIX time s is at or after the
+// table's expiry
+func checkStale(s int64) {
+	if atomic.LoadInt32(&staleArmed) == 0 || s < tableExpiry() {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&staleArmed, 1, 0) {
+		return
+	}
+	staleMu.Lock()
+	funcs := staleFuncs
+	staleMu.Unlock()
+	for _, sf := range funcs {
+		go sf.f()
+	}
+}
+
+// rearmStale rearms the OnStale callbacks after the table's expiry changes
+func rearmStale() {
+	staleMu.Lock()
+	defer staleMu.Unlock()
+	if len(staleFuncs) > 0 {
+		atomic.StoreInt32(&staleArmed, 1)
+	}
+}
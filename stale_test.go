@@ -0,0 +1,80 @@
+package tai_test
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestIsStale(t *testing.T) {
+	exp := tai.LeapTableExpiry()
+	if tai.IsStale(exp.Add(-1, 0)) || !tai.IsStale(exp) {
+		t.Fatalf("expected the table to be stale from %+v", exp)
+	}
+	by := tai.StaleBy()
+	if stale := tai.IsStale(tai.Now()); stale != tai.Dur(0, 0).Less(by) {
+		t.Fatalf("expected StaleBy to be positive only when stale, got %v with IsStale %v", by, stale)
+	}
+}
+
+func TestOnStale(t *testing.T) {
+	expires, _ := tai.LeapTableExpiry().Unix()
+	old := tai.SetLeapExpiry(expires)
+	t.Cleanup(func() { tai.SetLeapExpiry(old) })
+	fired := make(chan struct{}, 4)
+	unregister := tai.OnStale(func() { fired <- struct{}{} })
+	defer unregister()
+	expectFired := func(exp bool) {
+		t.Helper()
+		select {
+		case <-fired:
+			if !exp {
+				t.Fatal("expected no callback")
+			}
+		case <-time.After(50 * time.Millisecond):
+			if exp {
+				t.Fatal("expected a callback")
+			}
+		}
+	}
+	tai.Unix(expires-10, 0)
+	expectFired(false)
+	tai.Unix(expires+10, 0)
+	tai.Unix(expires+20, 0).AsTime()
+	expectFired(true)
+	expectFired(false)
+
+	// a table expiring a day later rearms the callbacks
+	embedded, err := ioutil.ReadFile("leap-seconds.list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const ntpUnixSkew = 2208988800
+	var lines []string
+	for _, l := range strings.Split(string(embedded), "\n") {
+		switch {
+		case strings.HasPrefix(l, "#h"):
+		case strings.HasPrefix(l, "#@"):
+			lines = append(lines, "#@\t"+strconv.FormatInt(expires+ntpUnixSkew+86400, 10))
+		default:
+			lines = append(lines, l)
+		}
+	}
+	if err := tai.LoadLeapSeconds(strings.NewReader(strings.Join(lines, "\n"))); err != nil {
+		t.Fatal(err)
+	}
+	tai.Unix(expires+10, 0)
+	expectFired(false)
+	tai.Unix(expires+2*86400, 0)
+	expectFired(true)
+
+	// an unregistered callback is not called
+	unregister()
+	tai.SetLeapExpiry(expires)
+	tai.Unix(expires+10, 0)
+	expectFired(false)
+}
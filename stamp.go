@@ -0,0 +1,83 @@
+package tai
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Stamp is a TAI moment recorded together with its UTC rendering and the leap
+// second table used to make it, for archives that must remain interpretable
+// after the table or the conversion policies change.  The TAI moment is
+// authoritative; the UTC rendering records what the writer believed the
+// civil time to be.
+type Stamp struct {
+	// TAI is the moment
+	TAI TAI
+	// UTC is the moment in UTC as RFC 3339 with up to nanosecond precision,
+	// e.g. 2016-12-31T23:59:60.5Z during a leap second
+	UTC string
+	// LeapTableHash identifies the leap second table the UTC rendering was
+	// made with; see func HashLeapSeconds
+	LeapTableHash string
+}
+
+// NewStamp returns the Stamp of t, rendered with the current leap second table
+// and Config
+func NewStamp(t TAI) Stamp {
+	return Snapshot().Stamp(t)
+}
+
+// Stamp returns the Stamp of t, rendered with the table and configuration of
+// c.  Unless c smears UTC, an instant within a leap second is rendered as
+// second 60.
+func (c *Converter) Stamp(t TAI) Stamp {
+	var utc string
+	if c.config.Smear == SmearNone && inLeapSecondIn(c.leaps, t.sec-unixEpochSkew) {
+		secs, nsecs := c.AsUnix(t)
+		prev := time.Unix(secs-1, nsecs).UTC()
+		utc = prev.Format("2006-01-02T15:04:") + "60" + prev.Format(".999999999Z07:00")
+	} else {
+		utc = c.AsTime(t).Format(time.RFC3339Nano)
+	}
+	return Stamp{TAI: t, UTC: utc, LeapTableHash: c.LeapTableHash()}
+}
+
+// Converter returns a Converter with the leap second table the Stamp was
+// written with, so that other conversions may be made as the writer would
+// have made them.  An error is returned if the table is not known; see func
+// FindLeapTable.
+func (s Stamp) Converter() (*Converter, error) {
+	table, ok := FindLeapTable(s.LeapTableHash)
+	if !ok {
+		return nil, fmt.Errorf("Stamp.Converter: unknown leap table %s", s.LeapTableHash)
+	}
+	return NewConverter(table, Config{})
+}
+
+// stampJSON is the JSON form of a Stamp, with TAI in its canonical decimal
+// form; see func AppendDecimal
+type stampJSON struct {
+	TAI           string `json:"tai"`
+	UTC           string `json:"utc"`
+	LeapTableHash string `json:"leapTableHash"`
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Stamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(stampJSON{TAI: s.TAI.Decimal(), UTC: s.UTC, LeapTableHash: s.LeapTableHash})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *Stamp) UnmarshalJSON(b []byte) error {
+	var j stampJSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return fmt.Errorf("Stamp.UnmarshalJSON: %w", err)
+	}
+	t, err := ParseDecimal(j.TAI)
+	if err != nil {
+		return fmt.Errorf("Stamp.UnmarshalJSON: %w", err)
+	}
+	*s = Stamp{TAI: t, UTC: j.UTC, LeapTableHash: j.LeapTableHash}
+	return nil
+}
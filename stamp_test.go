@@ -0,0 +1,63 @@
+package tai_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestNewStamp(t *testing.T) {
+	after := tai.Unix(leap2017, 0)
+	cases := []struct {
+		descr string
+		t     tai.TAI
+		exp   string
+	}{
+		{"Ordinary", tai.Unix(1719835200, 5e8), "2024-07-01T12:00:00.5Z"},
+		{"LeapSecond", after.Add(-1, 5e17), "2016-12-31T23:59:60.5Z"},
+		{"AfterLeapSecond", after, "2017-01-01T00:00:00Z"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			s := tai.NewStamp(tc.t)
+			if s.UTC != tc.exp {
+				t.Errorf("expected %s, got %s", tc.exp, s.UTC)
+			}
+			if !s.TAI.Eq(tc.t) || s.LeapTableHash != tai.LeapTableHash() {
+				t.Errorf("expected the moment and current table, got %+v", s)
+			}
+		})
+	}
+}
+
+func TestStampJSONRoundTrip(t *testing.T) {
+	s := tai.NewStamp(tai.Unix(1719835200, 0).Add(0, 7))
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got tai.Stamp
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != s {
+		t.Fatalf("expected %+v, got %+v from %s", s, got, b)
+	}
+	c, err := got.Converter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.LeapTableHash() != s.LeapTableHash {
+		t.Errorf("expected the writer's table, got %s", c.LeapTableHash())
+	}
+	if err := json.Unmarshal([]byte(`{"tai":"1.5"}`), &got); err == nil {
+		t.Error("expected an error for an invalid decimal TAI")
+	}
+}
+
+func TestStampUnknownTable(t *testing.T) {
+	if _, err := (tai.Stamp{LeapTableHash: "f00d"}).Converter(); err == nil {
+		t.Error("expected an error for an unknown table")
+	}
+}
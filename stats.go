@@ -0,0 +1,69 @@
+package tai
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Mean returns the mean of ts, exact to the attosecond and rounded toward the
+// past.  It panics if ts is empty.
+func Mean(ts []TAI) TAI {
+	if len(ts) == 0 {
+		panic("tai.Mean: empty slice")
+	}
+	sum := new(big.Int)
+	for _, t := range ts {
+		sum.Add(sum, t.big())
+	}
+	return taiFromBig(sum.Div(sum, big.NewInt(int64(len(ts)))))
+}
+
+// Median returns the median of ts, which need not be sorted.  For an even
+// number of timestamps it is the midpoint of the middle two, rounded toward the
+// past.  It panics if ts is empty.
+func Median(ts []TAI) TAI {
+	if len(ts) == 0 {
+		panic("tai.Median: empty slice")
+	}
+	s := append([]TAI(nil), ts...)
+	sort.Slice(s, func(i, j int) bool { return s[i].Before(s[j]) })
+	mid := len(s) / 2
+	if len(s)%2 == 1 {
+		return s[mid]
+	}
+	sum := new(big.Int).Add(s[mid-1].big(), s[mid].big())
+	return taiFromBig(sum.Div(sum, big.NewInt(2)))
+}
+
+// Jitter returns the standard deviation of the differences between successive
+// timestamps of ts, in the order given, such as the arrival times of periodic
+// events.  It is the population standard deviation, exact to the attosecond
+// and rounded down.  Fewer than three timestamps have no jitter.
+func Jitter(ts []TAI) Duration {
+	if len(ts) < 3 {
+		return Duration{}
+	}
+	// n Σd² - (Σd)² is n² times the variance
+	sum, sumSq := new(big.Int), new(big.Int)
+	for i := 1; i < len(ts); i++ {
+		d := new(big.Int).Sub(ts[i].big(), ts[i-1].big())
+		sum.Add(sum, d)
+		sumSq.Add(sumSq, d.Mul(d, d))
+	}
+	n := big.NewInt(int64(len(ts) - 1))
+	v := new(big.Int).Mul(n, sumSq)
+	v.Sub(v, sum.Mul(sum, sum))
+	v.Sqrt(v)
+	return durationFromBig(v.Div(v, n))
+}
+
+// big returns t as a number of attoseconds since the epoch
+func (t TAI) big() *big.Int {
+	return Duration{sec: t.sec, asec: t.asec}.big()
+}
+
+// taiFromBig returns the TAI time b attoseconds after the epoch
+func taiFromBig(b *big.Int) TAI {
+	d := durationFromBig(b)
+	return TAI{sec: d.sec, asec: d.asec}
+}
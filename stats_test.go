@@ -0,0 +1,81 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestMean(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	cases := []struct {
+		descr string
+		in    []tai.TAI
+		exp   tai.TAI
+	}{
+		{"One", []tai.TAI{base}, base},
+		{"Two", []tai.TAI{base, base.Add(1, 0)}, base.Add(0, 5e17)},
+		{"Attoseconds", []tai.TAI{base, base.Add(0, 1), base.Add(0, 1)}, base},
+		{"Negative", []tai.TAI{base.Add(-1, 0), base.Add(0, -1)}, base.Add(-1, 5e17-1)},
+		{"Extremes", []tai.TAI{tai.Tai(-1<<62, 0), tai.Tai(1<<62, 0)}, tai.Tai(0, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tai.Mean(tc.in); !got.Eq(tc.exp) {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	odd := []tai.TAI{base.Add(5, 0), base, base.Add(1, 0)}
+	if got := tai.Median(odd); !got.Eq(base.Add(1, 0)) {
+		t.Fatalf("expected the middle timestamp, got %v", got)
+	}
+	if !odd[0].Eq(base.Add(5, 0)) {
+		t.Fatal("expected the input to be left unsorted")
+	}
+	even := []tai.TAI{base.Add(3, 0), base, base.Add(0, 3), base.Add(10, 0)}
+	if got, exp := tai.Median(even), base.Add(1, 5e17+1); !got.Eq(exp) {
+		t.Fatalf("expected %v, got %v", exp, got)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	periodic := []tai.TAI{base, base.Add(1, 0), base.Add(2, 0), base.Add(3, 0)}
+	if got := tai.Jitter(periodic); got != (tai.Duration{}) {
+		t.Fatalf("expected no jitter, got %v", got)
+	}
+	// differences of 1, 3, 1, 3 s have a standard deviation of 1 s
+	uneven := []tai.TAI{base, base.Add(1, 0), base.Add(4, 0), base.Add(5, 0), base.Add(8, 0)}
+	if got, exp := tai.Jitter(uneven), tai.Dur(1, 0); got != exp {
+		t.Fatalf("expected %v, got %v", exp, got)
+	}
+	// attosecond differences are not lost to floating point
+	fine := []tai.TAI{base, base.Add(1e9, 0), base.Add(2e9, 2)}
+	if got, exp := tai.Jitter(fine), tai.Dur(0, 1); got != exp {
+		t.Fatalf("expected %v, got %v", exp, got)
+	}
+	if got := tai.Jitter(periodic[:2]); got != (tai.Duration{}) {
+		t.Fatalf("expected no jitter for one difference, got %v", got)
+	}
+}
+
+func TestStatsEmpty(t *testing.T) {
+	for name, f := range map[string]func(){
+		"Mean":   func() { tai.Mean(nil) },
+		"Median": func() { tai.Median(nil) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("expected a panic")
+				}
+			}()
+			f()
+		})
+	}
+}
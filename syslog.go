@@ -0,0 +1,137 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrSyslogNil is returned when parsing the NILVALUE ("-") of an RFC 5424
+// syslog message, which has no timestamp
+var ErrSyslogNil = errors.New("tai: syslog timestamp is NILVALUE")
+
+// SyslogTimestamp returns t formatted as the TIMESTAMP of an RFC 5424 syslog
+// message, in UTC with microsecond precision, e.g. 2021-09-03T22:03:56.991894Z
+//
+// RFC 5424 does not permit leap seconds; a time within an inserted leap
+// second is formatted in the second after it, the first of the next minute, as
+// by AsTime.
+func (t TAI) SyslogTimestamp() string {
+	secs, asec := t.unix()
+	y, m, d, h, mi, s := civilFromUnix(secs)
+	b := make([]byte, 0, 27)
+	b = appendInt(b, int64(y), 4)
+	b = append(b, '-')
+	b = appendInt(b, int64(m), 2)
+	b = append(b, '-')
+	b = appendInt(b, int64(d), 2)
+	b = append(b, 'T')
+	b = appendInt(b, int64(h), 2)
+	b = append(b, ':')
+	b = appendInt(b, int64(mi), 2)
+	b = append(b, ':')
+	b = appendInt(b, int64(s), 2)
+	b = append(b, '.')
+	b = appendInt(b, asec/Microsecond, 6)
+	return string(append(b, 'Z'))
+}
+
+// ParseSyslogTimestamp parses the TIMESTAMP of an RFC 5424 syslog message,
+// e.g. 2003-10-11T22:14:15.003Z or 2003-08-24T05:14:15.000003-07:00, and
+// converts it to TAI with the leap second table.
+//
+// ErrSyslogNil is returned for the NILVALUE "-".  As required by RFC 5424, the
+// time must have at most six fractional digits and must not be a leap second.
+func ParseSyslogTimestamp(s string) (TAI, error) {
+	if s == "-" {
+		return TAI{}, ErrSyslogNil
+	}
+	p := parser{s: s}
+	y := p.digits(4)
+	p.expect('-')
+	mo := p.digits(2)
+	p.expect('-')
+	d := p.digits(2)
+	p.expect('T')
+	h := p.digits(2)
+	p.expect(':')
+	mi := p.digits(2)
+	p.expect(':')
+	sec := p.digits(2)
+	var us int64
+	if p.peek() == '.' {
+		p.next()
+		us = p.fraction(6)
+	}
+	var offset int64
+	switch c := p.next(); c {
+	case 'Z':
+	case '+', '-':
+		oh := p.digits(2)
+		p.expect(':')
+		om := p.digits(2)
+		if oh > 23 || om > 59 {
+			p.fail("offset out of range")
+		}
+		offset = int64(oh)*Hour + int64(om)*Minute
+		if c == '-' {
+			offset = -offset
+		}
+	default:
+		p.fail("expected offset")
+	}
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return TAI{}, fmt.Errorf("ParseSyslogTimestamp: %w", p.err)
+	}
+	if err := validCivil(y, mo, d, h, mi, sec); err != nil {
+		return TAI{}, fmt.Errorf("ParseSyslogTimestamp: %w", err)
+	}
+	if sec == 60 {
+		return TAI{}, errors.New("ParseSyslogTimestamp: leap seconds are not permitted")
+	}
+	return unixAsec(unixFromCivil(y, mo, d, h, mi, sec)-offset, us*Microsecond), nil
+}
+
+// FromJournalRealtime returns the TAI time of a systemd journal
+// __REALTIME_TIMESTAMP, the number of microseconds since the UNIX epoch in the
+// UTC time system.  The leap second table is consulted in making the
+// conversion; see func Unix.
+func FromJournalRealtime(us uint64) TAI {
+	return unixAsec(int64(us/1e6), int64(us%1e6)*Microsecond)
+}
+
+// JournalRealtime returns t as a systemd journal __REALTIME_TIMESTAMP,
+// truncated toward the past to microseconds.  Times before the UNIX epoch
+// are not representable, and return zero.
+func (t TAI) JournalRealtime() uint64 {
+	secs, asec := t.unix()
+	if secs < 0 {
+		return 0
+	}
+	return uint64(secs)*1e6 + uint64(asec/Microsecond)
+}
+
+// JournalBoot returns the TAI moment at which the monotonic clock of a boot
+// read zero, estimated from the __REALTIME_TIMESTAMP and
+// __MONOTONIC_TIMESTAMP fields of one journal entry of that boot.
+//
+// Since the monotonic clock does not step, entries of the same boot
+// (_BOOT_ID) are placed on the atomic timescale more reliably by
+// FromJournalMonotonic with one boot estimate than by their own realtime
+// fields, which follow any adjustment of the system clock.
+func JournalBoot(realtime, monotonic uint64) TAI {
+	return FromJournalRealtime(realtime).AddDuration(journalMicros(monotonic).Neg())
+}
+
+// FromJournalMonotonic returns the TAI time of a journal
+// __MONOTONIC_TIMESTAMP, microseconds since boot, given the boot estimate
+// returned by JournalBoot.
+func FromJournalMonotonic(monotonic uint64, boot TAI) TAI {
+	return boot.AddDuration(journalMicros(monotonic))
+}
+
+func journalMicros(us uint64) Duration {
+	return Dur(int64(us/1e6), int64(us%1e6)*Microsecond)
+}
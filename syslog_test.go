@@ -0,0 +1,111 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestParseSyslogTimestamp(t *testing.T) {
+	cases := []struct {
+		descr string
+		in    string
+		exp   time.Time
+	}{
+		// the examples of RFC 5424 section 6.2.3.1
+		{"UTC", "1985-04-12T23:20:50.52Z", time.Date(1985, 4, 12, 23, 20, 50, 520000000, time.UTC)},
+		{"Offset", "1985-04-12T19:20:50.52-04:00", time.Date(1985, 4, 12, 23, 20, 50, 520000000, time.UTC)},
+		{"Micros", "2003-10-11T22:14:15.003Z", time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC)},
+		{"Micros2", "2003-08-24T05:14:15.000003-07:00", time.Date(2003, 8, 24, 12, 14, 15, 3000, time.UTC)},
+		{"NoFraction", "2024-07-01T00:00:00+05:30", time.Date(2024, 6, 30, 18, 30, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, err := tai.ParseSyslogTimestamp(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := tai.FromTime(tc.exp); !got.Eq(exp) {
+				t.Fatalf("expected %v, got %v", tc.exp, got.AsTime())
+			}
+		})
+	}
+}
+
+func TestParseSyslogTimestampInvalid(t *testing.T) {
+	if _, err := tai.ParseSyslogTimestamp("-"); err != tai.ErrSyslogNil {
+		t.Fatalf("expected ErrSyslogNil, got %v", err)
+	}
+	// the invalid examples of RFC 5424 section 6.2.3.2
+	for _, s := range []string{
+		"1985-04-12T23:20:50.52",
+		"2003-10-11T22:14:15.003Z ",
+		"2003-08-24T05:14:15.000000003-07:00",
+		"2003-08-24T05:14:15",
+		"2016-12-31T23:59:60Z",
+		"2024-02-30T00:00:00Z",
+		"2024-07-01T00:00:00+24:00",
+	} {
+		if _, err := tai.ParseSyslogTimestamp(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
+
+func TestSyslogTimestampRoundTrip(t *testing.T) {
+	ta := tai.FromTime(time.Date(2024, 7, 1, 12, 34, 56, 789012000, time.UTC))
+	s := ta.SyslogTimestamp()
+	if exp := "2024-07-01T12:34:56.789012Z"; s != exp {
+		t.Fatalf("expected %s, got %s", exp, s)
+	}
+	got, err := tai.ParseSyslogTimestamp(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(ta) {
+		t.Fatalf("expected %v, got %v", ta.AsTime(), got.AsTime())
+	}
+}
+
+func TestSyslogTimestampLeapSecond(t *testing.T) {
+	// the TAI second inserted as 2016-12-31T23:59:60Z
+	leap := tai.FromTime(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)).Add(-1, 0)
+	cases := []struct {
+		descr string
+		inp   tai.TAI
+		exp   string
+	}{
+		{"Before", leap.Add(-1, 0), "2016-12-31T23:59:59.000000Z"},
+		{"Start", leap, "2017-01-01T00:00:00.000000Z"},
+		{"Within", leap.Add(0, 5e17), "2017-01-01T00:00:00.500000Z"},
+		{"After", leap.Add(1, 0), "2017-01-01T00:00:00.000000Z"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if s := tc.inp.SyslogTimestamp(); s != tc.exp {
+				t.Fatalf("expected %s, got %s", tc.exp, s)
+			}
+		})
+	}
+}
+
+func TestJournalTimestamps(t *testing.T) {
+	const realtime uint64 = 1719837296123456
+	ta := tai.FromJournalRealtime(realtime)
+	if exp := tai.FromTime(time.Unix(0, int64(realtime)*1e3)); !ta.Eq(exp) {
+		t.Fatalf("expected %v, got %v", exp.AsTime(), ta.AsTime())
+	}
+	if got := ta.JournalRealtime(); got != realtime {
+		t.Fatalf("expected %d, got %d", realtime, got)
+	}
+	// an entry 90 seconds after boot places later entries of that boot
+	boot := tai.JournalBoot(realtime, 90e6)
+	if got := tai.FromJournalMonotonic(90e6, boot); !got.Eq(ta) {
+		t.Fatalf("expected %v, got %v", ta.AsTime(), got.AsTime())
+	}
+	later := tai.FromJournalMonotonic(90e6+1500000, boot)
+	if exp := ta.Add(1, 5e17); !later.Eq(exp) {
+		t.Fatalf("expected %v, got %v", exp.AsTime(), later.AsTime())
+	}
+}
@@ -4,16 +4,29 @@ package tai
 import (
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
+	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const (
 	RFC3339      = "%Y-%m-%dT%H:%M:%S%Z"
+	RFC3339Milli = "%Y-%m-%dT%H:%M:%S.%3N%Z"
 	RFC3339Micro = "%Y-%m-%dT%H:%M:%S.%f%Z"
 	RFC3339Nano  = "%Y-%m-%dT%H:%M:%S.%F%Z"
+	RFC3339Pico  = "%Y-%m-%dT%H:%M:%S.%12N%Z"
+	RFC3339Femto = "%Y-%m-%dT%H:%M:%S.%15N%Z"
+	RFC3339Atto  = "%Y-%m-%dT%H:%M:%S.%18N%Z"
+	// RFC3339Trimmed has up to 18 fractional digits, with trailing zeros
+	// removed, e.g. 2021-09-03T22:03:56.5Z or 2021-09-03T22:03:56Z
+	RFC3339Trimmed = "%Y-%m-%dT%H:%M:%S.%-18N%Z"
+	// FIX is the UTCTimestamp of the FIX protocol, with up to 12 fractional
+	// digits, e.g. 20210903-22:03:56.991
+	FIX = "%Y%m%d-%H:%M:%S.%-12N"
+	// Syslog is the TIMESTAMP of a BSD (RFC 3164) syslog message, e.g.
+	// Sep  3 22:03:56.  It has no year.
+	Syslog = "%b %e %H:%M:%S"
 	// Second is the base unit for TAI and UNIX time since epoch
 	Second = 1
 
@@ -54,35 +67,39 @@ var (
 	// update is made invalid
 	PkgUpToDateUntil = Gregorian{Year: 2025, Month: January, Day: 1}
 
+	// leaps is the built-in leap second table, the same as leap-seconds.list;
+	// see leapfile.go.  Each entry is at 00:00:00 UTC on January 1 or July 1,
+	// the end of the leap second; the table once had them at midnight in US
+	// Pacific time, up to a day early.
 	leaps = []leap{
-		{63100800, 10},
-		{78735600, 11},
-		{94636800, 12},
-		{126172800, 13},
-		{157708800, 14},
-		{189244800, 15},
-		{220867200, 16},
-		{252403200, 17},
-		{283939200, 18},
-		{315475200, 19},
-		{362732400, 20},
-		{394268400, 21},
-		{425804400, 22},
-		{488962800, 23},
-		{567936000, 24},
-		{631094400, 25},
-		{662630400, 26},
-		{709887600, 27},
-		{741423600, 28},
-		{772959600, 29},
-		{820396800, 30},
-		{867654000, 31},
-		{915091200, 32},
-		{1136016000, 33},
-		{1230710400, 34},
-		{1341039600, 35},
-		{1435647600, 36},
-		{1483171200, 37},
+		{63072000, 10},
+		{78796800, 11},
+		{94694400, 12},
+		{126230400, 13},
+		{157766400, 14},
+		{189302400, 15},
+		{220924800, 16},
+		{252460800, 17},
+		{283996800, 18},
+		{315532800, 19},
+		{362793600, 20},
+		{394329600, 21},
+		{425865600, 22},
+		{489024000, 23},
+		{567993600, 24},
+		{631152000, 25},
+		{662688000, 26},
+		{709948800, 27},
+		{741484800, 28},
+		{773020800, 29},
+		{820454400, 30},
+		{867715200, 31},
+		{915148800, 32},
+		{1136073600, 33},
+		{1230768000, 34},
+		{1341100800, 35},
+		{1435708800, 36},
+		{1483228800, 37},
 	}
 	minLeaps = len(leaps)
 	leaplock sync.RWMutex
@@ -133,6 +150,7 @@ func RegisterLeapSecond(unixUTC int64, cumulativeSkew int64) error {
 		if unixUTC > l.UnixUTC {
 			// leaps is explicitly sorted
 			leaps = insertLeap(leaps, i+1, leap{UnixUTC: unixUTC, CumulativeSkew: cumulativeSkew})
+			atomic.AddUint64(&leapGen, 1)
 			return nil
 		} else if unixUTC == l.UnixUTC {
 			if cumulativeSkew != l.CumulativeSkew {
@@ -163,21 +181,38 @@ func RemoveLeapSecond(unixUTC int64) {
 				panic("tai.RemoveLeapSecond: would result in fewer leap seconds than IERS has announced")
 			}
 			leaps = removeLeap(leaps, i)
+			atomic.AddUint64(&leapGen, 1)
 		}
 	}
 }
 
-func skewUnix(s int64) int64 {
+// skewUnix returns TAI-UTC at s, which is a UNIX time if dir is UTCToTAI, or
+// the number of TAI seconds since the UNIX epoch if dir is TAIToUTC
+func skewUnix(s int64, dir ConversionDirection) int64 {
 	leaplock.RLock()
 	defer leaplock.RUnlock()
-	for i := len(leaps) - 1; i > 0; i-- {
+	skew, i := skewLeaps(leaps, s, dir)
+	if auditing() {
+		audit(s, dir, i)
+	}
+	return skew
+}
+
+// skewLeaps is skewUnix for an arbitrary table, also returning the index of
+// the entry used, or -1 if s is before the table
+func skewLeaps(leaps []leap, s int64, dir ConversionDirection) (int64, int) {
+	for i := len(leaps) - 1; i >= 0; i-- {
 		// loop in reverse; very likely to be after the last leapsecond
 		l := leaps[i]
-		if s > l.UnixUTC {
-			return l.CumulativeSkew
+		start := l.UnixUTC
+		if dir == TAIToUTC {
+			start += l.CumulativeSkew
+		}
+		if s >= start {
+			return l.CumulativeSkew, i
 		}
 	}
-	return 0
+	return 0, -1
 }
 
 // TODO: permit > 1e18 Asec - but how?  Exported fields means that user can
@@ -186,6 +221,13 @@ func skewUnix(s int64) int64 {
 // TAI represents an international atomic time (TAI) moment
 //
 // The zero value of TAI represents the atomic time Epoch of Jan 1, 1958 at 00:00:00
+//
+// the zero value is an ordinary instant, and is encoded as one: MarshalBinary
+// writes BinaryLen zero bytes and MarshalText writes
+// 0000000000.000000000000000000, both of which decode to the zero value.
+// encoding/json's omitempty does not omit a TAI, which is a struct; use a
+// *TAI for an optional field.  Code that uses the zero value to mean unset
+// should test for it with IsZero, and so cannot represent the epoch itself.
 type TAI struct {
 	// Sec is the number of whole seconds since TAI Epoch
 	sec int64
@@ -194,19 +236,57 @@ type TAI struct {
 	asec int64
 }
 
+// Epoch returns the TAI epoch, 1958-01-01 00:00:00 TAI, which is the zero
+// value of TAI
+func Epoch() TAI {
+	return TAI{}
+}
+
+// IsZero returns true if t is the zero value, the TAI epoch
+func (t TAI) IsZero() bool {
+	return t.sec == 0 && t.asec == 0
+}
+
+// ErrTAIRange is returned by TaiChecked when the instant is beyond the range
+// of TAI, about 292 billion years either side of 1958
+var ErrTAIRange = errors.New("tai: time out of range of TAI")
+
+// Tai returns the instant sec seconds and asec attoseconds after the TAI
+// epoch.  asec need not be in [0, 1e18); whole seconds of it are carried into
+// sec.  If the carry overflows int64, sec wraps around as int64 arithmetic
+// does; see TaiChecked to detect it, e.g. for untrusted input.
 func Tai(sec, asec int64) TAI {
+	t, _ := normalize(sec, asec)
+	return t
+}
 
+// TaiChecked is Tai, returning ErrTAIRange rather than wrapping around
+func TaiChecked(sec, asec int64) (TAI, error) {
+	t, ok := normalize(sec, asec)
+	if !ok {
+		return TAI{}, ErrTAIRange
+	}
+	return t, nil
+}
+
+// normalize carries the whole seconds of asec into sec, returning false if sec
+// overflows and wraps around
+func normalize(sec, asec int64) (TAI, bool) {
 	spareSecs := asec / 1e18
 	asec %= 1e18
-	sec += spareSecs
-
 	//by definition 0 <= asec < 1e18
 	if asec < 0 {
 		asec += 1e18
-		sec -= Second
+		spareSecs--
 	}
+	ok := !(spareSecs > 0 && sec > math.MaxInt64-spareSecs) && !(spareSecs < 0 && sec < math.MinInt64-spareSecs)
+	return TAI{sec: sec + spareSecs, asec: asec}, ok
+}
 
-	return TAI{sec: sec, asec: asec}
+// Parts returns the number of whole seconds since the TAI epoch and the
+// attoseconds of fractional time, with 0 <= asec < 1e18
+func (t TAI) Parts() (sec, asec int64) {
+	return t.sec, t.asec
 }
 
 // Before returns true if t is before o
@@ -237,55 +317,96 @@ func (t TAI) Eq(o TAI) bool {
 
 }
 
+// Compare returns -1 if t is before o, +1 if t is after o, and 0 if they are
+// the same instant, as time.Time.Compare does, for use with sort and search
+// functions
+func (t TAI) Compare(o TAI) int {
+	switch {
+	case t.Before(o):
+		return -1
+	case t.After(o):
+		return +1
+	}
+	return 0
+}
+
 // FromGreg returns the TAI value corresponding to a moment in the Proleptic Gregorian Calendar
 //
 // FromGreg can be replaced by a pair of calls to Date(...).AddHMS and insertion
 // of an Asec value
 func FromGregorian(g Gregorian) TAI {
-	d := DaysFromCivil(int(g.Year), int(g.Month), int(g.Day))
-	s := SecsEpochFromDays(d)
-	return Tai(int64(s), g.Asec)
+	d := DaysFromCivil64(g.Year, int(g.Month), g.Day)
+	s := SecsEpochFromDays64(d) + int64(g.Hour)*Hour + int64(g.Min)*Minute + int64(g.Sec)
+	return Tai(s, g.Asec)
 }
 
 // AsGreg converts a TAI timestamp to a time in the Gregorian Calendar
 func (t TAI) AsGregorian() Gregorian {
-	d := DaysFromSecsEpoch(t.sec)
-	Y, M, D := CivilFromDays(d)
-	rem := t.sec % Day
-	// these two for loops are needed
-	// because Go has truncated division
-	// the latter is needed because the former
-	// may run for multiple iterations
-	for rem < 0 {
-		rem += Day
-		D--
-	}
-	for rem >= Day {
-		rem -= Day
-		D++
-	}
+	// floored division, so that instants before the epoch fall on the
+	// preceding day and not the following one
+	d, rem := floorDiv(t.sec, Day)
+	return gregorianFromDays(d, rem, t.asec)
+}
+
+// gregorianFromDays breaks the moment rem seconds and asec attoseconds into
+// the given day since the epoch into its calendar parts
+func gregorianFromDays(days, rem, asec int64) Gregorian {
+	Y, M, D := CivilFromDays64(days)
 	hr := rem / Hour
 	rem %= Hour
 	mn := rem / Minute
 	rem %= Minute
 	return Gregorian{
 		Year:  Y,
-		Month: M,
+		Month: Month(M),
 		Day:   D,
 		Hour:  int(hr),
 		Min:   int(mn),
 		Sec:   int(rem),
-		Asec:  t.asec,
+		Asec:  asec,
 	}
 }
 
+// Month returns the month of the year of t in the TAI calendar
+func (t TAI) Month() Month {
+	return t.AsGregorian().Month
+}
+
 // Unix returns the UNIX representation of t with nanosecond resolution
 func (t TAI) Unix() (secs, nsecs int64) {
+	secs, asecs := t.unix()
+	return secs, asecs / Nanosecond
+}
+
+// unix returns the UNIX representation of t with attosecond resolution
+func (t TAI) unix() (secs, asecs int64) {
+	return t.unixWith(currentConfig().Smear)
+}
+
+// UnixAsec is func (TAI) Unix with attosecond resolution, 0 <= asecs < 1e18
+func (t TAI) UnixAsec() (secs, asecs int64) {
+	return t.unix()
+}
+
+// UnixDuration returns the UNIX representation of t as the Duration since the
+// UNIX epoch, with attosecond resolution
+func (t TAI) UnixDuration() Duration {
+	secs, asecs := t.unix()
+	return Duration{sec: secs, asec: asecs}
+}
+
+// unixWith is unix with the given smear policy
+func (t TAI) unixWith(smear Smear) (secs, asecs int64) {
+	if smear == SmearLinear {
+		if secs, asecs, ok := smearToUTC(t); ok {
+			return secs, asecs
+		}
+	}
 	secs = t.sec - unixEpochSkew
-	nsecs = t.asec / Nanosecond
-	skew := skewUnix(secs)
+	skew := skewUnix(secs, TAIToUTC)
 	secs -= skew
-	return
+	checkStale(secs)
+	return secs, t.asec
 }
 
 // Unix returns the TAI time corresponding the the given UNIX time in the UTC
@@ -303,18 +424,59 @@ func (t TAI) Unix() (secs, nsecs int64) {
 // Unix has nsec resolution for equivalence to the stdlib Time package, but TAI
 // times have one billion times the precision.
 func Unix(seconds, nsec int64) TAI {
-	skew := skewUnix(seconds)
+	return unixAsec(seconds, nsec*Nanosecond)
+}
+
+// UnixAsec returns the TAI time corresponding to the given UNIX time, as
+// Unix but with attosecond resolution
+func UnixAsec(seconds, asec int64) TAI {
+	return unixAsec(seconds, asec)
+}
+
+// unixAsec is Unix with attosecond resolution; asec may be any value and is
+// normalized as by Tai
+func unixAsec(seconds, asec int64) TAI {
+	return unixAsecWith(seconds, asec, currentConfig().Smear)
+}
+
+// UnixDuration returns the TAI time corresponding to the UNIX time d after
+// the UNIX epoch; it is the inverse of func (TAI) UnixDuration
+func UnixDuration(d Duration) TAI {
+	return unixAsec(d.sec, d.asec)
+}
+
+// unixAsecWith is unixAsec with the given smear policy
+func unixAsecWith(seconds, asec int64, smear Smear) TAI {
+	if smear == SmearLinear {
+		if t, ok := smearFromUTC(seconds, asec); ok {
+			return t
+		}
+	}
+	skew := skewUnix(seconds, UTCToTAI)
+	checkStale(seconds)
 	seconds += unixEpochSkew
 	seconds += skew
-	return TAI{sec: seconds, asec: nsec * Nanosecond}
+	return Tai(seconds, asec)
+}
+
+// floorDiv returns the quotient and remainder of a/b rounded toward negative
+// infinity, so that 0 <= r < b for positive b
+func floorDiv(a, b int64) (q, r int64) {
+	q, r = a/b, a%b
+	if r < 0 {
+		q--
+		r += b
+	}
+	return q, r
 }
 
 // Now returns the current TAI moment, up to the level of maintenance in the
 // leapsecond table.  Consult the func tai.Unix documentation for further
 // information.
+//
+// the precision of Now is that of the host clock; see func NowPrecision.
 func Now() TAI {
-	now := time.Now() // no .UTC, done in FromTime
-	return FromTime(now)
+	return Unix(hostNow())
 }
 
 // Date returns the TAI value that corresponds to y,m,d in the Proleptic Gregorian Calendar
@@ -322,9 +484,7 @@ func Now() TAI {
 // if y/m/d are outside the expected range (m in [1,12], days ~= in [1,30] depending on m)
 // the behavior is undefined and the result will likely be quietly incorrect
 func Date(y, m, d int) TAI {
-	d = DaysFromCivil(y, m, d)
-	s := SecsEpochFromDays(d)
-	return TAI{sec: int64(s), asec: 0}
+	return TAI{sec: SecsEpochFromDays64(DaysFromCivil64(y, m, d))}
 }
 
 // AddHMS returns t offset by the given hours, minutes, and seconds
@@ -394,6 +554,10 @@ func FromTime(t time.Time) TAI {
 //
 // - %d Day of month as a two digit number, e.g. 12.
 //
+// - %e Day of month padded to two characters with a space, e.g. " 3"
+//
+// - %o Day of month with its ordinal suffix, e.g. 3rd
+//
 // - %b Month as abbreviated name, e.g. Sept
 //
 // - %B Unabbreviated Month, e.g. September
@@ -402,7 +566,15 @@ func FromTime(t time.Time) TAI {
 //
 // - %y Year without century or millenium; two digits, e.g. 2012==12
 //
-// - %Y Year with century/millenium, e.g. 2021
+// - %Y Year with century/millenium, e.g. 2021.  Years are numbered
+// astronomically, as by AsGregorian: 1 BCE is year 0 and 2 BCE is -1.  %y is
+// the last two digits of the absolute value of the year.
+//
+// - %EC Era, e.g. CE or BCE
+//
+// - %Ey Year of the era, e.g. 44 for 44 BCE (year -43)
+//
+// - %EY Year and era, e.g. 44 BCE; equivalent to "%Ey %EC"
 //
 // - %H 24-hour clock Hour as a two digit number, e.g. 22
 //
@@ -418,109 +590,245 @@ func FromTime(t time.Time) TAI {
 //
 // - %F Nanosecond as a nine digit decimal number
 //
-// - %z The letter "Z" (timezone, but TAI only exists in the UTC timezone)
+// - %N Fractional second with a width of 1 to 18 digits, nine if omitted, e.g.
+// %3N for milliseconds or %18N for attoseconds.  With the - flag, e.g. %-9N,
+// trailing zeros are removed; if none remain, a '.' or ',' immediately before
+// the specifier is removed as well.
+//
+// - %Z The letter "Z" (timezone, but TAI only exists in the UTC timezone)
 //
 // - %j Ordinal day of year, e.g. 364
 //
 // - %U Week number of the year, with Sunday as the first day of the week
 //
+// - %% A literal percent sign
+//
+// names, ordinal suffixes, and eras are those of the Locale set with WithLocale,
+// English by default.
 // Format panics if an unknown specifier is used.
 func (t TAI) Format(fmtspec string) string {
-	f := []rune(fmtspec)
-	g := t.AsGregorian()
-	d := DaysFromSecsEpoch(t.sec)
-	wd := WeekdayFromDays(d)
-	ily := IsLeapYear(int(g.Year))
-	// the ordinal day of year is the number of days prior to the current
-	// month, plus the day of the month
-	// if it's a leapyear and the month is at least march, there
-	// is an extra day
-	doy := daysBeforeNonLeapMonth[g.Month]
-	if ily && g.Month > 2 {
-		doy++
-	}
-	doy += int(g.Day)
+	return FormatGregorian(t.AsGregorian(), fmtspec)
+}
+
+// stringLayout is the layout of func String
+const stringLayout = "%Y-%m-%dT%H:%M:%S.%-18N TAI"
+
+// String implements fmt.Stringer, formatting t in the TAI calendar with as
+// many fractional digits as are needed to represent it exactly, e.g.
+// 2024-07-01T12:34:56.5 TAI.  The suffix distinguishes it from a UTC time.
+func (t TAI) String() string {
+	return t.Format(stringLayout)
+}
+
+// FormatGregorian formats g with the same specifiers as func Format.  This
+// allows a Gregorian obtained from AsGregorian to be reused for both its fields
+// and one or more textual representations, without the conversion from TAI
+// being repeated.
+func FormatGregorian(g Gregorian, fmtspec string) string {
+	b := make([]byte, 0, len(fmtspec)+24)
+	return string(appendFormat(b, g, fmtspec))
+}
+
+// appendFormat appends g formatted according to fmtspec to b
+func appendFormat(b []byte, g Gregorian, fmtspec string) []byte {
+	return appendFormatLocale(b, g, fmtspec, currentConfig().locale())
+}
+
+// appendFormatLocale is appendFormat with the names of loc
+func appendFormatLocale(b []byte, g Gregorian, fmtspec string, loc *Locale) []byte {
+	wd := int(g.Weekday())
+	doy := g.YearDay()
 	woy := doy / 7
-	var (
-		b    strings.Builder
-		last rune
-		next rune
-	)
-	b.Grow(len(f) + 10)
 	// parsing the string "%y-%m"
 	// we hit %, do not copy
 	// y, trigger specifier, do not copy literally
 	//
-	// conditions
-	// last == %, do specifier
-	// next == %, advance
-	for i := 0; i < len(f); i++ {
-		next = f[i]
-		if next == '%' {
-			if last == '%' {
-				// allow users to write percent signs
-				b.WriteRune('%')
-			}
-			last = next
+	// specifiers are all ASCII, so the spec is scanned bytewise and any
+	// other UTF-8 text is copied verbatim
+	for i := 0; i < len(fmtspec); i++ {
+		next := fmtspec[i]
+		if next != '%' {
+			b = append(b, next)
 			continue
 		}
-		if last == '%' {
-			switch next {
-			case 'a':
-				b.WriteString(weekdayNamesAbbrev[wd])
-			case 'A':
-				b.WriteString(weekdayNames[wd])
-			case 'w':
-				b.WriteString(strconv.Itoa(wd))
-			case 'd':
-				b.WriteString(fmt.Sprintf("%02d", g.Day))
-			case 'b':
-				b.WriteString(monthNamesAbbrev[g.Month])
-			case 'B':
-				b.WriteString(monthNamesFull[g.Month])
-			case 'm':
-				b.WriteString(fmt.Sprintf("%02d", g.Month))
+		i++
+		if i == len(fmtspec) {
+			break
+		}
+		next = fmtspec[i]
+		width, trim := 9, false
+		if next == '-' || (next >= '0' && next <= '9') {
+			var ok bool
+			width, trim, i, ok = parseFracSpec(fmtspec, i)
+			if !ok {
+				panic("tai/Format: invalid format specifier, flags and widths are only valid for %N with 1 to 18 digits")
+			}
+			next = 'N'
+		}
+		switch next {
+		case '%':
+			// allow users to write percent signs
+			b = append(b, '%')
+		case 'a':
+			b = append(b, loc.WeekdaysAbbrev[wd]...)
+		case 'A':
+			b = append(b, loc.Weekdays[wd]...)
+		case 'w':
+			b = appendInt(b, int64(wd), 1)
+		case 'd':
+			b = appendInt(b, int64(g.Day), 2)
+		case 'e':
+			if g.Day >= 0 && g.Day < 10 {
+				b = append(b, ' ')
+			}
+			b = appendInt(b, int64(g.Day), 1)
+		case 'o':
+			b = appendInt(b, int64(g.Day), 1)
+			if g.Day >= 1 && g.Day <= 31 {
+				b = append(b, loc.Ordinals[g.Day-1]...)
+			}
+		case 'b':
+			b = append(b, loc.month(g.Month, true)...)
+		case 'B':
+			b = append(b, loc.month(g.Month, false)...)
+		case 'm':
+			b = appendInt(b, int64(g.Month), 2)
+		case 'y':
+			y := g.Year % 100
+			if y < 0 {
+				y = -y
+			}
+			b = appendInt(b, int64(y), 2)
+		case 'Y':
+			b = appendInt(b, int64(g.Year), 1)
+		case 'E':
+			i++
+			if i == len(fmtspec) {
+				panic("tai/Format: invalid format specifier, %E must be followed by C, y, or Y")
+			}
+			era, yoe := eraOf(g.Year)
+			switch fmtspec[i] {
+			case 'C':
+				b = append(b, loc.Eras[era]...)
 			case 'y':
-				y := fmt.Sprintf("%d", g.Year)
-				y = y[len(y)-2:]
-				b.WriteString(y)
+				b = appendInt(b, int64(yoe), 1)
 			case 'Y':
-				b.WriteString(fmt.Sprintf("%d", g.Year))
-			case 'H':
-				b.WriteString(fmt.Sprintf("%02d", g.Hour))
-			case 'I':
-				H := g.Hour
-				if H > 12 {
-					H -= 12
-				}
-				b.WriteString(fmt.Sprintf("%02d", H))
-			case 'p':
-				if g.Hour > 12 {
-					b.WriteString("PM")
-				}
-				b.WriteString("AM")
-			case 'M':
-				b.WriteString(fmt.Sprintf("%02d", g.Min))
-			case 'S':
-				b.WriteString(fmt.Sprintf("%02d", g.Sec))
-			case 'f':
-				b.WriteString(fmt.Sprintf("%06d", g.Asec/Microsecond))
-			case 'F':
-				b.WriteString(fmt.Sprintf("%09d", g.Asec/Nanosecond))
-			case 'Z':
-				b.WriteRune('Z')
-			case 'j':
-				b.WriteString(fmt.Sprintf("%03d", doy))
-			case 'U':
-				b.WriteString(fmt.Sprintf("%02d", woy))
+				b = appendInt(b, int64(yoe), 1)
+				b = append(b, ' ')
+				b = append(b, loc.Eras[era]...)
 			default:
-				panicmsg := fmt.Sprintf("tai/Format: invalid format specifier, saw %c, expected specifier where %c was", last, next)
-				panic(panicmsg)
+				panic(fmt.Sprintf("tai/Format: invalid format specifier, saw %%E%c, expected %%EC, %%Ey, or %%EY", fmtspec[i]))
+			}
+		case 'H':
+			b = appendInt(b, int64(g.Hour), 2)
+		case 'I':
+			H := g.Hour % 12
+			if H == 0 {
+				H = 12
+			}
+			b = appendInt(b, int64(H), 2)
+		case 'p':
+			if g.Hour >= 12 {
+				b = append(b, "PM"...)
+			} else {
+				b = append(b, "AM"...)
 			}
-		} else {
-			b.WriteRune(next)
+		case 'M':
+			b = appendInt(b, int64(g.Min), 2)
+		case 'S':
+			b = appendInt(b, int64(g.Sec), 2)
+		case 'f':
+			b = appendInt(b, g.Asec/Microsecond, 6)
+		case 'F':
+			b = appendInt(b, g.Asec/Nanosecond, 9)
+		case 'N':
+			b = appendFraction(b, g.Asec, width, trim)
+		case 'Z':
+			b = append(b, 'Z')
+		case 'j':
+			b = appendInt(b, int64(doy), 3)
+		case 'U':
+			b = appendInt(b, int64(woy), 2)
+		default:
+			panicmsg := fmt.Sprintf("tai/Format: invalid format specifier, saw %c, expected specifier where %c was", '%', next)
+			panic(panicmsg)
 		}
-		last = next
 	}
-	return b.String()
+	return b
+}
+
+// eraOf returns the era of the astronomical year y, 0 before year 1 and 1
+// after, and the year within the era, counted from one
+func eraOf(y int) (era, yoe int) {
+	if y < 1 {
+		return 0, 1 - y
+	}
+	return 1, y
+}
+
+// parseFracSpec parses the flag and width of a %N specifier beginning at
+// spec[i], and returns the index of the N
+func parseFracSpec(spec string, i int) (width int, trim bool, end int, ok bool) {
+	if spec[i] == '-' {
+		trim = true
+		i++
+	}
+	start := i
+	for ; i < len(spec) && spec[i] >= '0' && spec[i] <= '9'; i++ {
+		width = width*10 + int(spec[i]-'0')
+		if width > 18 {
+			return 0, false, i, false
+		}
+	}
+	if i == start {
+		width = 9
+	}
+	if i == len(spec) || spec[i] != 'N' || width == 0 {
+		return 0, false, i, false
+	}
+	return width, trim, i, true
+}
+
+// pow10 holds the powers of ten that fit in an int64
+var pow10 = [...]int64{1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18}
+
+// appendFraction appends the first width digits of the fractional second
+// asec to b, without trailing zeros if trim is true
+func appendFraction(b []byte, asec int64, width int, trim bool) []byte {
+	v := asec / pow10[18-width]
+	if trim {
+		for width > 0 && v%10 == 0 {
+			v /= 10
+			width--
+		}
+		if width == 0 {
+			if n := len(b); n > 0 && (b[n-1] == '.' || b[n-1] == ',') {
+				b = b[:n-1]
+			}
+			return b
+		}
+	}
+	return appendInt(b, v, width)
+}
+
+// appendInt appends v to b in decimal, zero padded to at least width digits
+func appendInt(b []byte, v int64, width int) []byte {
+	u := uint64(v)
+	if v < 0 {
+		b = append(b, '-')
+		u = uint64(-v)
+	}
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		buf[i] = byte('0' + u%10)
+		u /= 10
+	}
+	i--
+	buf[i] = byte('0' + u)
+	for w := len(buf) - i; w < width; w++ {
+		b = append(b, '0')
+	}
+	return append(b, buf[i:]...)
 }
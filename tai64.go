@@ -0,0 +1,74 @@
+package tai
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// tai64Epoch is the TAI64 label of the TAI epoch.  TAI64 labels count TAI
+// seconds from 2^62, which is 1970-01-01 00:00:00 TAI.
+const tai64Epoch = 1<<62 - unixEpochSkew
+
+// TAI64N returns the 12-byte TAI64N external format of t, seconds and
+// nanoseconds.  t is truncated to nanoseconds.
+//
+// the label is of the TAI instant of t, as the TAI64 specification defines
+// it.  daemontools and multilog instead label the UNIX time as though TAI-UTC
+// were 10 seconds; see taiio.LibtaiLabel.
+func (t TAI) TAI64N() [12]byte {
+	var b [12]byte
+	binary.BigEndian.PutUint64(b[:8], uint64(t.sec+tai64Epoch))
+	binary.BigEndian.PutUint32(b[8:], uint32(t.asec/Nanosecond))
+	return b
+}
+
+// FromTAI64N returns the TAI instant of the TAI64N external format b.
+// Nanoseconds beyond 999999999 are not valid and produce a normalized result.
+func FromTAI64N(b [12]byte) TAI {
+	sec := int64(binary.BigEndian.Uint64(b[:8])) - tai64Epoch
+	return Tai(sec, int64(binary.BigEndian.Uint32(b[8:]))*Nanosecond)
+}
+
+// AppendTAI64NLabel appends the textual TAI64N label of t to b, an @ followed
+// by 24 lowercase hexadecimal digits, e.g. @4000000066a1b2c3000003e8
+func (t TAI) AppendTAI64NLabel(b []byte) []byte {
+	const hex = "0123456789abcdef"
+	raw := t.TAI64N()
+	b = append(b, '@')
+	for _, c := range raw {
+		b = append(b, hex[c>>4], hex[c&0xf])
+	}
+	return b
+}
+
+// TAI64NLabelLen is the length of a textual TAI64N label
+const TAI64NLabelLen = 25
+
+// ParseTAI64NLabel parses a textual TAI64N label, as produced by
+// AppendTAI64NLabel.  Upper and lowercase hexadecimal digits are accepted.
+func ParseTAI64NLabel(s string) (TAI, error) {
+	if len(s) != TAI64NLabelLen || s[0] != '@' {
+		return TAI{}, errors.New("ParseTAI64NLabel: expected @ followed by 24 hexadecimal digits")
+	}
+	var raw [12]byte
+	for i := 0; i < 24; i++ {
+		c := s[1+i]
+		var v byte
+		switch {
+		case c >= '0' && c <= '9':
+			v = c - '0'
+		case c >= 'a' && c <= 'f':
+			v = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			v = c - 'A' + 10
+		default:
+			return TAI{}, fmt.Errorf("ParseTAI64NLabel: invalid hexadecimal digit %q at offset %d", c, 1+i)
+		}
+		raw[i/2] = raw[i/2]<<4 | v
+	}
+	if binary.BigEndian.Uint32(raw[8:]) > 999999999 {
+		return TAI{}, errors.New("ParseTAI64NLabel: nanoseconds out of range")
+	}
+	return FromTAI64N(raw), nil
+}
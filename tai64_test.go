@@ -0,0 +1,39 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestTAI64NLabel(t *testing.T) {
+	// 1970-01-01 00:00:00 TAI is the TAI64 label 2^62
+	ta := tai.Date(1970, 1, 1).Add(1, 500*tai.Nanosecond)
+	s := string(ta.AppendTAI64NLabel(nil))
+	if exp := "@4000000000000001000001f4"; s != exp {
+		t.Fatalf("expected %s, got %s", exp, s)
+	}
+	got, err := tai.ParseTAI64NLabel(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(ta) {
+		t.Fatalf("expected %+v, got %+v", ta.AsGregorian(), got.AsGregorian())
+	}
+}
+
+func TestTAI64NRoundTrip(t *testing.T) {
+	for _, ta := range []tai.TAI{tai.TAI{}, tai.Date(1900, 1, 1), tai.Date(2024, 7, 1).Add(0, 123456789*tai.Nanosecond)} {
+		if got := tai.FromTAI64N(ta.TAI64N()); !got.Eq(ta) {
+			t.Errorf("expected %+v, got %+v", ta.AsGregorian(), got.AsGregorian())
+		}
+	}
+}
+
+func TestParseTAI64NLabelInvalid(t *testing.T) {
+	for _, s := range []string{"", "4000000000000001000001f4", "@4000000000000001000001", "@400000000000000100000xf4", "@40000000000000013b9aca00"} {
+		if _, err := tai.ParseTAI64NLabel(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
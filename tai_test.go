@@ -2,7 +2,9 @@ package tai_test
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -41,7 +43,7 @@ func fuzzTaiToGreg(t *testing.T, cases int) {
 		if date.Year != year {
 			failparts = append(failparts, fmt.Sprintf("wrong year: got %d expected %d", date.Year, year))
 		}
-		if date.Month != month {
+		if date.Month != tai.Month(month) {
 			failparts = append(failparts, fmt.Sprintf("wrong month: got %d expected %d", date.Month, month))
 		}
 		if date.Day != day {
@@ -84,6 +86,8 @@ func TestLessSpecialCasesGreg(t *testing.T) {
 		{"LastJulianDay", tai.Date(1582, tai.October, 4), tai.Gregorian{Year: 1582, Month: 10, Day: 4}},
 		{"BrokenFuzzCase1NoHMS", tai.Date(81, 3, 15), tai.Gregorian{Year: 81, Month: 3, Day: 15}},
 		{"BrokenFuzzCase1", tai.Date(81, 3, 15).AddHMS(11, 1, 18), tai.Gregorian{Year: 81, Month: 3, Day: 15, Hour: 11, Min: 1, Sec: 18}},
+		{"OneSecondBeforeEpoch", tai.Tai(-1, 0), tai.Gregorian{Year: 1957, Month: 12, Day: 31, Hour: 23, Min: 59, Sec: 59}},
+		{"LastSecondOfFebruaryBeforeEpoch", tai.Date(1900, 3, 1).Add(-1, 0), tai.Gregorian{Year: 1900, Month: 2, Day: 28, Hour: 23, Min: 59, Sec: 59}},
 	}
 	for _, tc := range cases {
 		t.Run(tc.descr, func(t *testing.T) {
@@ -94,6 +98,36 @@ func TestLessSpecialCasesGreg(t *testing.T) {
 		})
 	}
 }
+
+func TestAsGregorianWeekdayYearDay(t *testing.T) {
+	cases := []struct {
+		descr   string
+		inp     tai.TAI
+		weekday tai.Weekday
+		yearDay int
+	}{
+		{"Epoch", tai.TAI{}, tai.Wednesday, 1},
+		{"LeapYearEnd", tai.Date(2024, 12, 31).AddHMS(23, 59, 59), tai.Tuesday, 366},
+		{"NonLeapMarch", tai.Date(2023, 3, 1), tai.Wednesday, 60},
+		{"BeforeEpoch", tai.Tai(-1, 0), tai.Tuesday, 365},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			g := tc.inp.AsGregorian()
+			if g.Weekday() != tc.weekday || g.YearDay() != tc.yearDay {
+				t.Fatalf("expected %v day %d, got %v day %d", tc.weekday, tc.yearDay, g.Weekday(), g.YearDay())
+			}
+		})
+	}
+}
+
+func TestAsGregorianComparable(t *testing.T) {
+	g := tai.Date(2024, 7, 1).AddHMS(12, 5, 9).AsGregorian()
+	if exp := (tai.Gregorian{Year: 2024, Month: tai.July, Day: 1, Hour: 12, Min: 5, Sec: 9}); g != exp {
+		t.Fatalf("expected %+v, got %+v", exp, g)
+	}
+}
+
 func TestZeroTaiIsEpoch(t *testing.T) {
 	var ta tai.TAI
 	date := ta.AsGregorian()
@@ -142,6 +176,107 @@ func TestTaiFormat(t *testing.T) {
 	}
 }
 
+func TestTaiFormatSpecifiers(t *testing.T) {
+	// Monday, July 1, 2024 at 00:05:09
+	midnight := tai.Date(2024, 7, 1).AddHMS(0, 5, 9)
+	noon := tai.Date(2024, 7, 1).AddHMS(12, 5, 9)
+	cases := []struct {
+		descr string
+		inp   tai.TAI
+		spec  string
+		exp   string
+	}{
+		{"Names", midnight, "%a %A %b %B", "Mon Monday Jul July"},
+		{"Numbers", midnight, "%w %d %m %y %Y %j", "1 01 07 24 2024 183"},
+		{"TwelveHourMidnight", midnight, "%I:%M:%S %p", "12:05:09 AM"},
+		{"TwelveHourNoon", noon, "%I:%M:%S %p", "12:05:09 PM"},
+		{"PercentLiteral", midnight, "100%% %Y", "100% 2024"},
+		{"PercentBeforeSpecifierLetter", midnight, "%%Y", "%Y"},
+		{"Unicode", midnight, "%Y年%m月", "2024年07月"},
+		{"ShortYear", tai.Date(105, 1, 1), "%y %Y", "05 105"},
+		{"OrdinalDays", midnight, "%o %B", "1st July"},
+		{"OrdinalTeens", tai.Date(2024, 7, 13), "%o", "13th"},
+		{"SpacePaddedDay", midnight, "%e|%b %e", " 1|Jul  1"},
+		{"SpacePaddedTwoDigitDay", tai.Date(2024, 7, 13), "%e", "13"},
+		{"OrdinalTwenties", tai.Date(2024, 7, 22), "%o %d", "22nd 22"},
+		{"EraCE", midnight, "%Ey %EC|%EY", "2024 CE|2024 CE"},
+		{"EraBCE", tai.Date(-43, 3, 15), "%o %B %EY", "15th March 44 BCE"},
+		{"EraYearZero", tai.Date(0, 1, 1), "%Y %EY", "0 1 BCE"},
+		{"NegativeYear", tai.Date(-43, 3, 15), "%Y %y", "-43 43"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.inp.Format(tc.spec); got != tc.exp {
+				t.Fatalf("expected %q, got %q", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestTaiFormatFractions(t *testing.T) {
+	base := tai.Date(2024, 7, 1).AddHMS(12, 5, 9)
+	frac := base.Add(0, 123456789012345678)
+	half := base.Add(0, 5e17)
+	cases := []struct {
+		descr string
+		inp   tai.TAI
+		spec  string
+		exp   string
+	}{
+		{"Milli", frac, tai.RFC3339Milli, "2024-07-01T12:05:09.123Z"},
+		{"Micro", frac, tai.RFC3339Micro, "2024-07-01T12:05:09.123456Z"},
+		{"Nano", frac, tai.RFC3339Nano, "2024-07-01T12:05:09.123456789Z"},
+		{"Pico", frac, tai.RFC3339Pico, "2024-07-01T12:05:09.123456789012Z"},
+		{"Femto", frac, tai.RFC3339Femto, "2024-07-01T12:05:09.123456789012345Z"},
+		{"Atto", frac, tai.RFC3339Atto, "2024-07-01T12:05:09.123456789012345678Z"},
+		{"DefaultWidth", frac, "%N", "123456789"},
+		{"OneDigit", frac, "%1N", "1"},
+		{"TrimmedHalf", half, tai.RFC3339Trimmed, "2024-07-01T12:05:09.5Z"},
+		{"TrimmedWhole", base, tai.RFC3339Trimmed, "2024-07-01T12:05:09Z"},
+		{"TrimmedAll", frac, tai.RFC3339Trimmed, "2024-07-01T12:05:09.123456789012345678Z"},
+		{"TrimmedWidth", half.Add(0, 1), "%S,%-3N", "09,5"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.inp.Format(tc.spec); got != tc.exp {
+				t.Fatalf("expected %q, got %q", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestTaiFormatTwelveHourAndShortYear(t *testing.T) {
+	day := tai.Date(2024, 7, 1)
+	cases := []struct {
+		descr string
+		inp   tai.TAI
+		spec  string
+		exp   string
+		old   string // the output before %I, %p, and %y were corrected
+	}{
+		{"Midnight", day.AddHMS(0, 5, 9), "%I:%M %p", "12:05 AM", "00:05 AM"},
+		{"Morning", day.AddHMS(11, 5, 9), "%I:%M %p", "11:05 AM", "11:05 AM"},
+		{"Noon", day.AddHMS(12, 5, 9), "%I:%M %p", "12:05 PM", "12:05 AM"},
+		{"Afternoon", day.AddHMS(15, 5, 9), "%I:%M %p", "03:05 PM", "03:05 PMAM"},
+		{"OneDigitYear", tai.Date(5, 1, 1), "%y", "05", "a panic"},
+		{"NegativeOneDigitYear", tai.Date(-5, 1, 1), "%y", "05", "-5"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.inp.Format(tc.spec); got != tc.exp {
+				t.Fatalf("expected %q (formerly %s), got %q", tc.exp, tc.old, got)
+			}
+		})
+	}
+}
+
+func TestFormatGregorianComputesWeekday(t *testing.T) {
+	g := tai.Gregorian{Year: 2024, Month: tai.July, Day: 1}
+	if got := tai.FormatGregorian(g, "%a %j"); got != "Mon 183" {
+		t.Fatalf("expected \"Mon 183\", got %q", got)
+	}
+}
+
 func TestNowAsTimeEq(t *testing.T) {
 	now := tai.Now()
 	nowT := now.AsTime()
@@ -203,6 +338,42 @@ func TestTaiNegativeAsecs(t *testing.T) {
 	}
 }
 
+func TestTaiChecked(t *testing.T) {
+	cases := []struct {
+		descr     string
+		sec, asec int64
+		expSec    int64
+		expAsec   int64
+		overflow  bool
+	}{
+		{"Normal", 5, 2e18 + 3, 7, 3, false},
+		{"Borrow", 5, -1, 4, 1e18 - 1, false},
+		{"MaxExact", math.MaxInt64, 1e18 - 1, math.MaxInt64, 1e18 - 1, false},
+		{"MinExact", math.MinInt64, 0, math.MinInt64, 0, false},
+		{"CarryPastMax", math.MaxInt64, 1e18, math.MinInt64, 0, true},
+		{"BorrowPastMin", math.MinInt64, -1, math.MaxInt64, 1e18 - 1, true},
+		{"FarPastMin", math.MinInt64 + 3, math.MinInt64, math.MaxInt64 - 6, 776627963145224192, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, err := tai.TaiChecked(tc.sec, tc.asec)
+			if tc.overflow != (err != nil) {
+				t.Fatalf("expected overflow %v, got %v", tc.overflow, err)
+			}
+			if err != nil && err != tai.ErrTAIRange {
+				t.Fatalf("expected ErrTAIRange, got %v", err)
+			}
+			sec, asec := tai.Tai(tc.sec, tc.asec).Parts()
+			if sec != tc.expSec || asec != tc.expAsec {
+				t.Fatalf("expected Tai to give %d, %d, got %d, %d", tc.expSec, tc.expAsec, sec, asec)
+			}
+			if err == nil && !got.Eq(tai.Tai(tc.sec, tc.asec)) {
+				t.Fatalf("expected TaiChecked to agree with Tai, got %+v", got)
+			}
+		})
+	}
+}
+
 func TestTaiAdd(t *testing.T) {
 	t1 := tai.Tai(10, 5)
 	t2 := tai.Tai(13, 6)
@@ -267,6 +438,23 @@ func TestTaiAddNanoseconds(t *testing.T) {
 	}
 }
 
+func TestUnixAsec(t *testing.T) {
+	ta := tai.UnixAsec(1719837296, 123456789012345678)
+	if s, ns := ta.Unix(); s != 1719837296 || ns != 123456789 {
+		t.Fatalf("expected 1719837296.123456789, got %d.%09d", s, ns)
+	}
+	if s, as := ta.UnixAsec(); s != 1719837296 || as != 123456789012345678 {
+		t.Fatalf("expected the attoseconds to be kept, got %d.%018d", s, as)
+	}
+	d := ta.UnixDuration()
+	if s, as := d.Parts(); s != 1719837296 || as != 123456789012345678 {
+		t.Fatalf("expected the same Duration since the UNIX epoch, got %d.%018d", s, as)
+	}
+	if back := tai.UnixDuration(d); !back.Eq(ta) {
+		t.Fatalf("expected %+v, got %+v", ta, back)
+	}
+}
+
 func TestUnixEpoch(t *testing.T) {
 	ta := tai.Tai(4383*tai.Day, 0)
 	date := ta.AsGregorian()
@@ -297,6 +485,83 @@ func TestUnixEpoch(t *testing.T) {
 	}
 }
 
+func TestCompare(t *testing.T) {
+	base := tai.Date(2024, 7, 1)
+	cases := []struct {
+		descr string
+		a, b  tai.TAI
+		exp   int
+	}{
+		{"Equal", base, base, 0},
+		{"BeforeSec", base, base.Add(1, 0), -1},
+		{"AfterSec", base.Add(1, 0), base, 1},
+		{"BeforeAsec", base, base.Add(0, 1), -1},
+		{"AfterAsec", base.Add(0, 1), base, 1},
+		{"Negative", tai.Tai(-1, 5e17), tai.Tai(0, 0), -1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.a.Compare(tc.b); got != tc.exp {
+				t.Fatalf("expected %d, got %d", tc.exp, got)
+			}
+		})
+	}
+	ts := []tai.TAI{base.Add(2, 0), base, base.Add(1, 0)}
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Compare(ts[j]) < 0 })
+	i := sort.Search(len(ts), func(i int) bool { return ts[i].Compare(base.Add(1, 0)) >= 0 })
+	if i != 1 || !ts[0].Eq(base) {
+		t.Fatalf("expected to find the middle timestamp at 1, got %d", i)
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	if !tai.Epoch().IsZero() || !(tai.TAI{}).IsZero() {
+		t.Fatal("expected the epoch to be zero")
+	}
+	if !tai.Epoch().Eq(tai.Date(1958, 1, 1)) {
+		t.Fatalf("expected the epoch to be 1958-01-01, got %v", tai.Epoch())
+	}
+	for _, ta := range []tai.TAI{tai.Tai(0, 1), tai.Tai(-1, 0), tai.Tai(1, 0)} {
+		if ta.IsZero() {
+			t.Fatalf("expected %v not to be zero", ta)
+		}
+	}
+	b, err := tai.Epoch().MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back tai.TAI
+	if err := back.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if !back.IsZero() {
+		t.Fatalf("expected the zero value to round trip, got %v", back)
+	}
+}
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		descr string
+		in    tai.TAI
+		exp   string
+	}{
+		{"Epoch", tai.Epoch(), "1958-01-01T00:00:00 TAI"},
+		{"Fraction", tai.Date(2024, 7, 1).AddHMS(12, 34, 56).Add(0, 5e17), "2024-07-01T12:34:56.5 TAI"},
+		{"Attosecond", tai.Date(2024, 7, 1).Add(0, 1), "2024-07-01T00:00:00.000000000000000001 TAI"},
+		{"BeforeEpoch", tai.Tai(-1, 0), "1957-12-31T23:59:59 TAI"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.in.String(); got != tc.exp {
+				t.Fatalf("expected %s, got %s", tc.exp, got)
+			}
+			if got := fmt.Sprintf("%v", tc.in); got != tc.exp {
+				t.Fatalf("expected %%v to print %s, got %s", tc.exp, got)
+			}
+		})
+	}
+}
+
 func BenchmarkTaiAsTime(b *testing.B) {
 	now := tai.Now()
 	for i := 0; i < b.N; i++ {
@@ -311,6 +576,13 @@ func BenchmarkTaiFormat(b *testing.B) {
 	}
 }
 
+func BenchmarkFormatGregorian(b *testing.B) {
+	g := tai.Now().AsGregorian()
+	for i := 0; i < b.N; i++ {
+		tai.FormatGregorian(g, tai.RFC3339Micro)
+	}
+}
+
 // top level result of these two benchmarks: can reduce space by > 50% without
 // compromising time -> do so (keep changes)
 
@@ -329,7 +601,7 @@ func BenchmarkAsGregorianWithFmt(b *testing.B) {
 	now := tai.Now()
 	for i := 0; i < b.N; i++ {
 		g := now.AsGregorian()
-		fmt.Sprintf("%d %d %d %d %d %d %d", g.Year, g.Month, g.Day, g.Hour, g.Min, g.Sec, g.Asec)
+		_ = fmt.Sprintf("%d %d %d %d %d %d %d", g.Year, g.Month, g.Day, g.Hour, g.Min, g.Sec, g.Asec)
 	}
 }
 
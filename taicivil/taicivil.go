@@ -0,0 +1,402 @@
+// Package taicivil provides civil dates and times of day, apart from any
+// instant, for domains such as observing schedules and maintenance windows
+// that manipulate the two separately.  A CivilDate and TimeOfDay become an
+// instant only when combined on a time scale with func Combine.
+package taicivil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brandondube/tai"
+)
+
+// Scale is the time scale on which a civil date and time of day name an
+// instant
+type Scale int
+
+const (
+	// TAI is the TAI calendar of pkg tai, in which every day has 86400 seconds;
+	// see func tai.Date
+	TAI Scale = iota
+	// UTC is the calendar of UTC, in which a day ending with a leap second has
+	// a 61st second of its last minute, 23:59:60
+	UTC
+)
+
+// String returns the name of s
+func (s Scale) String() string {
+	switch s {
+	case TAI:
+		return "TAI"
+	case UTC:
+		return "UTC"
+	}
+	return fmt.Sprintf("Scale(%d)", int(s))
+}
+
+// CivilDate is a date in the proleptic Gregorian calendar
+type CivilDate struct {
+	Year  int
+	Month tai.Month
+	Day   int
+}
+
+// DateOf returns the date of t on the scale s
+func DateOf(t tai.TAI, s Scale) CivilDate {
+	d, _ := Split(t, s)
+	return d
+}
+
+// Valid returns true if d is a date that exists
+func (d CivilDate) Valid() bool {
+	return d.Month.Valid() && d.Day >= 1 && d.Day <= tai.DaysInMonth(int(d.Month), d.Year)
+}
+
+// days returns the number of days from the TAI epoch to d
+func (d CivilDate) days() int64 {
+	return tai.DaysFromCivil64(d.Year, int(d.Month), d.Day)
+}
+
+func dateFromDays(days int64) CivilDate {
+	y, m, dd := tai.CivilFromDays64(days)
+	return CivilDate{Year: y, Month: tai.Month(m), Day: dd}
+}
+
+// Before returns true if d is before o
+func (d CivilDate) Before(o CivilDate) bool {
+	return d.compare(o) < 0
+}
+
+// After returns true if d is after o
+func (d CivilDate) After(o CivilDate) bool {
+	return d.compare(o) > 0
+}
+
+// Eq returns true if d and o are the same date
+func (d CivilDate) Eq(o CivilDate) bool {
+	return d == o
+}
+
+func (d CivilDate) compare(o CivilDate) int {
+	switch {
+	case d.Year != o.Year:
+		return sign(d.Year - o.Year)
+	case d.Month != o.Month:
+		return sign(int(d.Month - o.Month))
+	}
+	return sign(d.Day - o.Day)
+}
+
+func sign(x int) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	}
+	return 0
+}
+
+// AddDays returns the date n days after d, or before it if n is negative
+func (d CivilDate) AddDays(n int) CivilDate {
+	return dateFromDays(d.days() + int64(n))
+}
+
+// AddMonths returns the date n months after d, or before it if n is negative.
+// The day is clamped to the end of a shorter month, e.g. one month after
+// January 31 is February 28 or 29.
+func (d CivilDate) AddMonths(n int) CivilDate {
+	m := d.Year*12 + int(d.Month) - 1 + n
+	y, mo := m/12, m%12
+	if mo < 0 {
+		y, mo = y-1, mo+12
+	}
+	out := CivilDate{Year: y, Month: tai.Month(mo + 1), Day: d.Day}
+	if dim := tai.DaysInMonth(int(out.Month), y); out.Day > dim {
+		out.Day = dim
+	}
+	return out
+}
+
+// DaysSince returns the number of days from o to d, negative if d is before o
+func (d CivilDate) DaysSince(o CivilDate) int {
+	return int(d.days() - o.days())
+}
+
+// Weekday returns the day of the week of d
+func (d CivilDate) Weekday() tai.Weekday {
+	return d.midnight().Weekday()
+}
+
+// AddWorkingDays returns the date n working days of cal after d, or before it
+// if n is negative; see func tai.TAI.AddWorkingDays.  A nil cal is
+// tai.MondayToFriday.
+func (d CivilDate) AddWorkingDays(n int, cal tai.Calendar) CivilDate {
+	return DateOf(d.midnight().AddWorkingDays(n, cal), TAI)
+}
+
+// IsWorkingDay returns true if d is a working day of cal; a nil cal is
+// tai.MondayToFriday
+func (d CivilDate) IsWorkingDay(cal tai.Calendar) bool {
+	if cal == nil {
+		cal = tai.MondayToFriday
+	}
+	return cal.IsWorkingDay(d.midnight())
+}
+
+// midnight returns the start of d in the TAI calendar
+func (d CivilDate) midnight() tai.TAI {
+	return tai.Tai(tai.SecsEpochFromDays64(d.days()), 0)
+}
+
+// String returns d in ISO 8601 format, e.g. 2024-07-01
+func (d CivilDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// ParseCivilDate parses a date in ISO 8601 format, e.g. 2024-07-01
+func ParseCivilDate(s string) (CivilDate, error) {
+	if !isCivilDate(s) {
+		return CivilDate{}, fmt.Errorf("ParseCivilDate: %q is not of the form YYYY-MM-DD", s)
+	}
+	d := CivilDate{Year: atoi(s[0:4]), Month: tai.Month(atoi(s[5:7])), Day: atoi(s[8:10])}
+	if !d.Valid() {
+		return CivilDate{}, fmt.Errorf("ParseCivilDate: %s does not exist", s)
+	}
+	return d, nil
+}
+
+// isCivilDate returns true if s has the fixed width form YYYY-MM-DD, with
+// ASCII digits and no sign or padding
+func isCivilDate(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; i {
+		case 4, 7:
+			if c != '-' {
+				return false
+			}
+		default:
+			if c < '0' || c > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// atoi returns the value of the ASCII digits s
+func atoi(s string) int {
+	v := 0
+	for i := 0; i < len(s); i++ {
+		v = v*10 + int(s[i]-'0')
+	}
+	return v
+}
+
+// TimeOfDay is a wall clock time, without a date.  Sec may be 60 during a
+// leap second on the UTC scale.
+type TimeOfDay struct {
+	Hour, Min, Sec int
+	Asec           int64
+}
+
+// Midnight is the first moment of the day
+var Midnight = TimeOfDay{}
+
+// TimeOfDayOf returns the time of day of t on the scale s
+func TimeOfDayOf(t tai.TAI, s Scale) TimeOfDay {
+	_, tod := Split(t, s)
+	return tod
+}
+
+// Valid returns true if every field of t is within its range; Sec may be 60
+func (t TimeOfDay) Valid() bool {
+	return t.Hour >= 0 && t.Hour < 24 && t.Min >= 0 && t.Min < 60 && t.Sec >= 0 && t.Sec <= 60 && t.Asec >= 0 && t.Asec < 1e18
+}
+
+// Before returns true if t is earlier in the day than o
+func (t TimeOfDay) Before(o TimeOfDay) bool {
+	return t.compare(o) < 0
+}
+
+// After returns true if t is later in the day than o
+func (t TimeOfDay) After(o TimeOfDay) bool {
+	return t.compare(o) > 0
+}
+
+// Eq returns true if t and o are the same time of day
+func (t TimeOfDay) Eq(o TimeOfDay) bool {
+	return t == o
+}
+
+func (t TimeOfDay) compare(o TimeOfDay) int {
+	switch {
+	case t.Hour != o.Hour:
+		return sign(t.Hour - o.Hour)
+	case t.Min != o.Min:
+		return sign(t.Min - o.Min)
+	case t.Sec != o.Sec:
+		return sign(t.Sec - o.Sec)
+	case t.Asec < o.Asec:
+		return -1
+	case t.Asec > o.Asec:
+		return 1
+	}
+	return 0
+}
+
+// SinceMidnight returns the time elapsed from midnight to t on a day without
+// a leap second
+func (t TimeOfDay) SinceMidnight() tai.Duration {
+	return tai.Dur(int64(t.Hour*tai.Hour+t.Min*tai.Minute+t.Sec), t.Asec)
+}
+
+// Add returns the time of day d after t, and the number of midnights crossed
+// in doing so: positive if the result is on a later day, negative if d is
+// negative and the result is on an earlier day.  Days are of 86400 seconds;
+// a time in a leap second (Sec of 60) is treated as the same fraction of the
+// first second of the next day.
+func (t TimeOfDay) Add(d tai.Duration) (TimeOfDay, int) {
+	sec, asec := t.SinceMidnight().Add(d).Parts()
+	days, rem := sec/tai.Day, sec%tai.Day
+	if rem < 0 {
+		days, rem = days-1, rem+tai.Day
+	}
+	return timeOfDay(rem, asec), int(days)
+}
+
+// timeOfDay returns the TimeOfDay sec seconds after midnight, for sec in
+// [0, 86400)
+func timeOfDay(sec, asec int64) TimeOfDay {
+	return TimeOfDay{Hour: int(sec / tai.Hour), Min: int(sec % tai.Hour / tai.Minute), Sec: int(sec % tai.Minute), Asec: asec}
+}
+
+// Sub returns the time elapsed from o to t on the same day, negative if t is
+// before o.  To find the time until the next occurrence of t after o, as
+// across midnight, use Until.
+func (t TimeOfDay) Sub(o TimeOfDay) tai.Duration {
+	return t.SinceMidnight().Sub(o.SinceMidnight())
+}
+
+// Until returns the time elapsed from t to the next occurrence of next, in
+// [0, 24h); if next is before t, this is next on the following day
+func (t TimeOfDay) Until(next TimeOfDay) tai.Duration {
+	d := next.Sub(t)
+	if d.IsNegative() {
+		d = d.Add(tai.Dur(tai.Day, 0))
+	}
+	return d
+}
+
+// Between returns true if t is within the half-open interval [start, end) of
+// the day.  If end is before start, the interval crosses midnight, e.g.
+// 23:00 to 01:00 contains 23:30 and 00:30 but not 12:00.  If start and end are
+// equal, the interval is empty.
+func (t TimeOfDay) Between(start, end TimeOfDay) bool {
+	if end.Before(start) {
+		return !t.Before(start) || t.Before(end)
+	}
+	return !t.Before(start) && t.Before(end)
+}
+
+// String returns t as HH:MM:SS, with the fraction of the second if nonzero,
+// e.g. 13:45:00 or 23:59:60.5
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Min, t.Sec)
+	if t.Asec != 0 {
+		f := fmt.Sprintf("%018d", t.Asec)
+		for f[len(f)-1] == '0' {
+			f = f[:len(f)-1]
+		}
+		s += "." + f
+	}
+	return s
+}
+
+// Combine returns the instant at time of day t on date d, on the scale s.
+//
+// an error is returned if d or t is invalid, or if t is in a leap second
+// (Sec of 60) and d does not end with one on the scale s; on the TAI scale,
+// no day does.  On the UTC scale, the second omitted by a negative leap
+// second, 23:59:59, is an error as well.
+func Combine(d CivilDate, t TimeOfDay, s Scale) (tai.TAI, error) {
+	if !d.Valid() {
+		return tai.TAI{}, fmt.Errorf("Combine: invalid date %s", d)
+	}
+	if !t.Valid() {
+		return tai.TAI{}, fmt.Errorf("Combine: invalid time of day %+v", t)
+	}
+	days := d.days()
+	switch s {
+	case TAI:
+		if t.Sec == 60 {
+			return tai.TAI{}, errors.New("Combine: the TAI scale has no leap seconds")
+		}
+		return d.midnight().AddDuration(t.SinceMidnight()), nil
+	case UTC:
+		midnight := unixDays(days)
+		if t.Sec == 60 {
+			if t.Hour != 23 || t.Min != 59 || !endsWithLeap(midnight+tai.Day) {
+				return tai.TAI{}, fmt.Errorf("Combine: %s does not end with a leap second", d)
+			}
+			// the instant is one second after 23:59:59
+			last, _ := tai.UnixOpts(midnight+tai.Day-1, 0, tai.ConvertOptions{})
+			return last.Add(1, t.Asec), nil
+		}
+		secs := midnight + int64(t.Hour*tai.Hour+t.Min*tai.Minute+t.Sec)
+		if _, err := tai.UnixOpts(secs, 0, tai.ConvertOptions{Strict: true}); errors.Is(err, tai.ErrSkippedSecond) {
+			return tai.TAI{}, fmt.Errorf("Combine: %s %s was omitted by a negative leap second", d, t)
+		}
+		out, _ := tai.UnixOpts(secs, 0, tai.ConvertOptions{})
+		return out.Add(0, t.Asec), nil
+	}
+	return tai.TAI{}, fmt.Errorf("Combine: unknown scale %v", s)
+}
+
+// Split returns the date and time of day of t on the scale s; it is the
+// inverse of Combine.  An unknown scale is treated as TAI.
+func Split(t tai.TAI, s Scale) (CivilDate, TimeOfDay) {
+	g := t.AsGregorian()
+	if s != UTC {
+		return CivilDate{Year: g.Year, Month: g.Month, Day: g.Day}, TimeOfDay{Hour: g.Hour, Min: g.Min, Sec: g.Sec, Asec: g.Asec}
+	}
+	// without smearing, UTC and TAI differ by whole seconds
+	leap := false
+	secs, _, err := t.UnixOpts(tai.ConvertOptions{Strict: true})
+	if errors.Is(err, tai.ErrLeapSecond) {
+		leap = true
+		secs, _, _ = t.Add(-1, 0).UnixOpts(tai.ConvertOptions{})
+	} else if err != nil {
+		secs, _, _ = t.UnixOpts(tai.ConvertOptions{})
+	}
+	days, rem := secs/tai.Day, secs%tai.Day
+	if rem < 0 {
+		days, rem = days-1, rem+tai.Day
+	}
+	d := dateFromDays(days + unixEpochDays)
+	tod := timeOfDay(rem, g.Asec)
+	if leap {
+		tod.Sec = 60
+	}
+	return d, tod
+}
+
+// unixEpochDays is the number of days from the TAI epoch to the UNIX epoch
+var unixEpochDays = tai.DaysFromCivil64(1970, 1, 1)
+
+// unixDays returns the UNIX time of the start of the day days after the TAI
+// epoch
+func unixDays(days int64) int64 {
+	return (days - unixEpochDays) * tai.Day
+}
+
+// endsWithLeap returns true if a positive leap second is inserted before the
+// UNIX time midnight
+func endsWithLeap(midnight int64) bool {
+	step, ok := tai.LeapSecondAt(midnight)
+	return ok && step > 0
+}
@@ -0,0 +1,227 @@
+package taicivil_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+	"github.com/brandondube/tai/taicivil"
+)
+
+func TestCombineSplit(t *testing.T) {
+	cases := []struct {
+		descr string
+		d     taicivil.CivilDate
+		tod   taicivil.TimeOfDay
+		s     taicivil.Scale
+		exp   tai.TAI
+	}{
+		{"TAI", taicivil.CivilDate{2024, tai.July, 1}, taicivil.TimeOfDay{13, 45, 0, 5}, taicivil.TAI, tai.Date(2024, 7, 1).AddHMS(13, 45, 0).Add(0, 5)},
+		{"UTC", taicivil.CivilDate{2024, tai.July, 1}, taicivil.TimeOfDay{13, 45, 0, 5}, taicivil.UTC, tai.Unix(1719841500, 0).Add(0, 5)},
+		{"UTCLeapSecond", taicivil.CivilDate{2016, tai.December, 31}, taicivil.TimeOfDay{23, 59, 60, 25e16}, taicivil.UTC, tai.Unix(1483228799, 0).Add(1, 25e16)},
+		{"UTCAfterLeap", taicivil.CivilDate{2017, tai.January, 1}, taicivil.Midnight, taicivil.UTC, tai.Unix(1483228800, 0)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, err := taicivil.Combine(tc.d, tc.tod, tc.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp, got)
+			}
+			d, tod := taicivil.Split(got, tc.s)
+			if d != tc.d || tod != tc.tod {
+				t.Fatalf("expected %s %s, got %s %s", tc.d, tc.tod, d, tod)
+			}
+		})
+	}
+}
+
+func TestCombineInvalid(t *testing.T) {
+	cases := []struct {
+		descr string
+		d     taicivil.CivilDate
+		tod   taicivil.TimeOfDay
+		s     taicivil.Scale
+	}{
+		{"Date", taicivil.CivilDate{2023, tai.February, 29}, taicivil.Midnight, taicivil.TAI},
+		{"TimeOfDay", taicivil.CivilDate{2024, tai.July, 1}, taicivil.TimeOfDay{Hour: 24}, taicivil.TAI},
+		{"LeapOnTAI", taicivil.CivilDate{2016, tai.December, 31}, taicivil.TimeOfDay{23, 59, 60, 0}, taicivil.TAI},
+		{"NoLeap", taicivil.CivilDate{2024, tai.July, 1}, taicivil.TimeOfDay{23, 59, 60, 0}, taicivil.UTC},
+		{"Scale", taicivil.CivilDate{2024, tai.July, 1}, taicivil.Midnight, taicivil.Scale(5)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if _, err := taicivil.Combine(tc.d, tc.tod, tc.s); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestCivilDateArithmetic(t *testing.T) {
+	jan31 := taicivil.CivilDate{2024, tai.January, 31}
+	cases := []struct {
+		descr string
+		got   taicivil.CivilDate
+		exp   taicivil.CivilDate
+	}{
+		{"AddDays", jan31.AddDays(30), taicivil.CivilDate{2024, tai.March, 1}},
+		{"SubDays", jan31.AddDays(-31), taicivil.CivilDate{2023, tai.December, 31}},
+		{"AddMonthsClamps", jan31.AddMonths(1), taicivil.CivilDate{2024, tai.February, 29}},
+		{"SubMonths", jan31.AddMonths(-2), taicivil.CivilDate{2023, tai.November, 30}},
+		{"AddYear", jan31.AddMonths(12), taicivil.CivilDate{2025, tai.January, 31}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if tc.got != tc.exp {
+				t.Fatalf("expected %s, got %s", tc.exp, tc.got)
+			}
+		})
+	}
+	if n := (taicivil.CivilDate{2024, tai.March, 1}).DaysSince(jan31); n != 30 {
+		t.Fatalf("expected 30 days, got %d", n)
+	}
+	if wd := jan31.Weekday(); wd != tai.Wednesday {
+		t.Fatalf("expected Wednesday, got %v", wd)
+	}
+}
+
+func TestCivilComparison(t *testing.T) {
+	a, b := taicivil.CivilDate{2024, tai.July, 1}, taicivil.CivilDate{2024, tai.June, 30}
+	if !b.Before(a) || !a.After(b) || a.Eq(b) {
+		t.Fatal("unexpected date comparison")
+	}
+	x, y := taicivil.TimeOfDay{12, 0, 0, 1}, taicivil.TimeOfDay{12, 0, 0, 0}
+	if !y.Before(x) || !x.After(y) || x.Eq(y) {
+		t.Fatal("unexpected time of day comparison")
+	}
+}
+
+func TestCivilStrings(t *testing.T) {
+	d, err := taicivil.ParseCivilDate("2024-02-29")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "2024-02-29" {
+		t.Fatalf("expected 2024-02-29, got %s", s)
+	}
+	for _, inp := range []string{
+		"2023-02-29",
+		"2024-7-1",
+		"2024-07-01T00",
+		"2024-7-011",
+		"2024-07-1x",
+		" 2024-07-1",
+		"+024-07-01",
+		"2024/07/01",
+	} {
+		if _, err := taicivil.ParseCivilDate(inp); err == nil {
+			t.Errorf("expected an error parsing %q", inp)
+		}
+	}
+	if s := (taicivil.TimeOfDay{23, 59, 60, 5e17}).String(); s != "23:59:60.5" {
+		t.Fatalf("expected 23:59:60.5, got %s", s)
+	}
+}
+
+func TestTimeOfDayAdd(t *testing.T) {
+	cases := []struct {
+		descr   string
+		tod     taicivil.TimeOfDay
+		d       tai.Duration
+		exp     taicivil.TimeOfDay
+		expDays int
+	}{
+		{"SameDay", taicivil.TimeOfDay{9, 30, 0, 0}, tai.Dur(90*tai.Minute, 0), taicivil.TimeOfDay{11, 0, 0, 0}, 0},
+		{"AcrossMidnight", taicivil.TimeOfDay{23, 30, 0, 0}, tai.Dur(tai.Hour, 0), taicivil.TimeOfDay{0, 30, 0, 0}, 1},
+		{"SeveralDays", taicivil.TimeOfDay{12, 0, 0, 0}, tai.Dur(3*tai.Day, 1), taicivil.TimeOfDay{12, 0, 0, 1}, 3},
+		{"Backward", taicivil.TimeOfDay{0, 15, 0, 0}, tai.Dur(-30*tai.Minute, 0), taicivil.TimeOfDay{23, 45, 0, 0}, -1},
+		{"BackwardFraction", taicivil.Midnight, tai.Dur(0, -1), taicivil.TimeOfDay{23, 59, 59, 1e18 - 1}, -1},
+		{"LeapSecond", taicivil.TimeOfDay{23, 59, 60, 5e17}, tai.Dur(0, 0), taicivil.TimeOfDay{0, 0, 0, 5e17}, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, days := tc.tod.Add(tc.d)
+			if got != tc.exp || days != tc.expDays {
+				t.Fatalf("expected %s and %d days, got %s and %d", tc.exp, tc.expDays, got, days)
+			}
+		})
+	}
+}
+
+func TestTimeOfDaySubUntil(t *testing.T) {
+	late, early := taicivil.TimeOfDay{23, 0, 0, 0}, taicivil.TimeOfDay{1, 0, 0, 0}
+	if d := early.Sub(late); !d.Eq(tai.Dur(-22*tai.Hour, 0)) {
+		t.Fatalf("expected -22h, got %v", d)
+	}
+	if d := late.Until(early); !d.Eq(tai.Dur(2*tai.Hour, 0)) {
+		t.Fatalf("expected 2h until the next 01:00, got %v", d)
+	}
+	if d := early.Until(early); !d.Eq(tai.Dur(0, 0)) {
+		t.Fatalf("expected zero, got %v", d)
+	}
+}
+
+func TestTimeOfDayBetween(t *testing.T) {
+	at := func(h, m int) taicivil.TimeOfDay { return taicivil.TimeOfDay{Hour: h, Min: m} }
+	cases := []struct {
+		descr      string
+		tod        taicivil.TimeOfDay
+		start, end taicivil.TimeOfDay
+		exp        bool
+	}{
+		{"Within", at(12, 0), at(9, 0), at(17, 0), true},
+		{"AtStart", at(9, 0), at(9, 0), at(17, 0), true},
+		{"AtEnd", at(17, 0), at(9, 0), at(17, 0), false},
+		{"Outside", at(8, 0), at(9, 0), at(17, 0), false},
+		{"OvernightLate", at(23, 30), at(23, 0), at(1, 0), true},
+		{"OvernightEarly", at(0, 30), at(23, 0), at(1, 0), true},
+		{"OvernightOutside", at(12, 0), at(23, 0), at(1, 0), false},
+		{"OvernightAtEnd", at(1, 0), at(23, 0), at(1, 0), false},
+		{"Empty", at(9, 0), at(9, 0), at(9, 0), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := tc.tod.Between(tc.start, tc.end); got != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestCivilDateAddWorkingDays(t *testing.T) {
+	fri := taicivil.CivilDate{2024, tai.July, 5}
+	if got, exp := fri.AddWorkingDays(1, nil), (taicivil.CivilDate{2024, tai.July, 8}); got != exp {
+		t.Fatalf("expected %s, got %s", exp, got)
+	}
+	if got, exp := fri.AddWorkingDays(-5, nil), (taicivil.CivilDate{2024, tai.June, 28}); got != exp {
+		t.Fatalf("expected %s, got %s", exp, got)
+	}
+	if fri.AddDays(1).IsWorkingDay(nil) {
+		t.Fatal("expected Saturday not to be a working day")
+	}
+}
+
+func TestCombineNegativeLeap(t *testing.T) {
+	const leap = 4102444800 // 2100-01-01
+	table := tai.LeapSeconds()
+	if err := tai.RegisterLeapSecond(leap, table[len(table)-1].CumulativeSkew-1); err != nil {
+		t.Fatal(err)
+	}
+	defer tai.RemoveLeapSecond(leap)
+	d := taicivil.CivilDate{2099, tai.December, 31}
+	if _, err := taicivil.Combine(d, taicivil.TimeOfDay{23, 59, 59, 0}, taicivil.UTC); err == nil {
+		t.Fatal("expected an error for the omitted second")
+	}
+	if _, err := taicivil.Combine(d, taicivil.TimeOfDay{23, 59, 60, 0}, taicivil.UTC); err == nil {
+		t.Fatal("expected an error for :60 on a negative leap day")
+	}
+	got, err := taicivil.Combine(d, taicivil.TimeOfDay{23, 59, 58, 0}, taicivil.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dd, tod := taicivil.Split(got.Add(1, 0), taicivil.UTC); dd != d.AddDays(1) || tod != taicivil.Midnight {
+		t.Fatalf("expected the second after 23:59:58 to be midnight, got %s %s", dd, tod)
+	}
+}
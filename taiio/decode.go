@@ -0,0 +1,92 @@
+package taiio
+
+import (
+	"bufio"
+	"io"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+// LocalLayout is the time layout of tai64nlocal's output, e.g.
+// 2024-07-01 12:34:56.123456789
+const LocalLayout = "2006-01-02 15:04:05.000000000"
+
+// Decoder rewrites TAI64N stamped streams, such as those produced by a Writer
+// or by multilog, into human readable times.  It is the equivalent of the
+// tai64nlocal utility.
+//
+// The zero value of Decoder reads the true TAI64N labels of the TAI64N stamp
+// and writes local times in LocalLayout.  Set Libtai to read the labels of
+// multilog and the LibtaiTAI64N stamp, as the C tai64nlocal does.
+type Decoder struct {
+	// Location is the time zone of the output; nil is time.Local.  Use
+	// time.UTC for UTC.
+	Location *time.Location
+	// Layout is the stdlib time layout of the output; empty is LocalLayout
+	Layout string
+	// Libtai reads labels as written by libtai and daemontools; see func
+	// LibtaiLabel
+	Libtai bool
+}
+
+// Decode copies r to w, replacing the TAI64N label at the start of each line
+// with the corresponding civil time.  True TAI64N labels are converted to UTC
+// with the leap second table.  Lines that do not begin with a valid label are copied
+// unchanged.
+func (d Decoder) Decode(w io.Writer, r io.Reader) error {
+	loc := d.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	layout := d.Layout
+	if layout == "" {
+		layout = LocalLayout
+	}
+	br := bufio.NewReader(r)
+	bw := bufio.NewWriter(w)
+	var buf []byte
+	for {
+		line, err := br.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			// a line longer than the buffer; only its first piece can hold a
+			// label, so decode it and stream the remainder
+			buf = append(buf[:0], d.decodeLine(line, loc, layout)...)
+			for err == bufio.ErrBufferFull {
+				if _, werr := bw.Write(buf); werr != nil {
+					return werr
+				}
+				line, err = br.ReadSlice('\n')
+				buf = append(buf[:0], line...)
+			}
+			line, buf = buf, nil
+		} else {
+			line = d.decodeLine(line, loc, layout)
+		}
+		if _, werr := bw.Write(line); werr != nil {
+			return werr
+		}
+		if err == io.EOF {
+			return bw.Flush()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// decodeLine returns line with its leading label, if any, replaced
+func (d Decoder) decodeLine(line []byte, loc *time.Location, layout string) []byte {
+	if len(line) < tai.TAI64NLabelLen || line[0] != '@' {
+		return line
+	}
+	t, err := tai.ParseTAI64NLabel(string(line[:tai.TAI64NLabelLen]))
+	if err != nil {
+		return line
+	}
+	if d.Libtai {
+		t = FromLibtaiLabel(t)
+	}
+	out := t.AsTime().In(loc).AppendFormat(nil, layout)
+	return append(out, line[tai.TAI64NLabelLen:]...)
+}
@@ -0,0 +1,83 @@
+package taiio_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+	"github.com/brandondube/tai/taiio"
+)
+
+func TestDecoderRoundTrip(t *testing.T) {
+	utc := time.Date(2024, 7, 1, 12, 34, 56, 123456789, time.UTC)
+	clock := &stepClock{now: tai.FromTime(utc)}
+	var stamped bytes.Buffer
+	w := taiio.NewWriter(&stamped, taiio.TAI64N, clock)
+	w.Write([]byte("hello\nworld\n"))
+	stamped.WriteString("not stamped\n@zzz invalid label\n")
+	stamped.WriteString(strings.Repeat("x", 10000))
+
+	var out bytes.Buffer
+	if err := (taiio.Decoder{Location: time.UTC}).Decode(&out, &stamped); err != nil {
+		t.Fatal(err)
+	}
+	exp := "2024-07-01 12:34:56.123456789 hello\n" +
+		"2024-07-01 12:34:56.123456789 world\n" +
+		"not stamped\n@zzz invalid label\n" + strings.Repeat("x", 10000)
+	if out.String() != exp {
+		t.Fatalf("expected\n%.200s\ngot\n%.200s", exp, out.String())
+	}
+}
+
+func TestDecoderLayoutAndZone(t *testing.T) {
+	utc := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	label := tai.FromTime(utc).AppendTAI64NLabel(nil)
+	in := string(label) + " x\n"
+	zone := time.FixedZone("EST", -5*3600)
+	var out bytes.Buffer
+	if err := (taiio.Decoder{Location: zone, Layout: time.RFC3339}).Decode(&out, strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	if exp := "2023-12-31T19:00:00-05:00 x\n"; out.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, out.String())
+	}
+}
+
+func TestDecoderLibtai(t *testing.T) {
+	// a label written by multilog, 2^62 + 10 + the UNIX time 994720184, which
+	// the C tai64nlocal prints in UTC as 2001-07-09 23:09:44.848605500
+	in := "@400000003b4a39c23294b13c fatal: out of memory\n"
+	cases := []struct {
+		descr  string
+		libtai bool
+		exp    string
+	}{
+		{"Libtai", true, "2001-07-09 23:09:44.848605500 fatal: out of memory\n"},
+		// TAI-UTC was 32 s in 2001
+		{"TrueTAI", false, "2001-07-09 23:09:22.848605500 fatal: out of memory\n"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			var out bytes.Buffer
+			d := taiio.Decoder{Location: time.UTC, Libtai: tc.libtai}
+			if err := d.Decode(&out, strings.NewReader(in)); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.exp {
+				t.Fatalf("expected %q, got %q", tc.exp, out.String())
+			}
+		})
+	}
+	// the LibtaiTAI64N stamp reads back in libtai mode
+	utc := time.Date(2024, 7, 1, 12, 34, 56, 123456789, time.UTC)
+	var stamped, out bytes.Buffer
+	taiio.NewWriter(&stamped, taiio.LibtaiTAI64N, &stepClock{now: tai.FromTime(utc)}).Write([]byte("x\n"))
+	if err := (taiio.Decoder{Location: time.UTC, Libtai: true}).Decode(&out, &stamped); err != nil {
+		t.Fatal(err)
+	}
+	if exp := "2024-07-01 12:34:56.123456789 x\n"; out.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, out.String())
+	}
+}
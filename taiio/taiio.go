@@ -0,0 +1,154 @@
+// Package taiio provides io.Writer and io.Reader adapters that prefix each
+// line of a stream with the TAI moment it was seen, in the manner of the
+// daemontools tai64n utility.
+package taiio
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brandondube/tai"
+)
+
+// Stamp is the textual format of the timestamp prefixed to each line
+type Stamp int
+
+const (
+	// TAI64N stamps are TAI64N labels of the true TAI instant, e.g.
+	// @4000000066a1b2c3000003e8.  The C tai64nlocal reads them as later than
+	// they are by TAI-UTC less 10 seconds; see LibtaiTAI64N.
+	TAI64N Stamp = iota
+	// RFC3339Nano stamps are formatted with tai.RFC3339Nano in the TAI
+	// calendar
+	RFC3339Nano
+	// LibtaiTAI64N stamps are TAI64N labels as written by daemontools' tai64n
+	// and multilog on a POSIX clock, which read back exactly with the C
+	// tai64nlocal; see func LibtaiLabel
+	LibtaiTAI64N
+)
+
+func (s Stamp) append(b []byte, t tai.TAI) []byte {
+	switch s {
+	case RFC3339Nano:
+		return t.AppendFormat(b, tai.RFC3339Nano)
+	case LibtaiTAI64N:
+		return LibtaiLabel(t).AppendTAI64NLabel(b)
+	}
+	return t.AppendTAI64NLabel(b)
+}
+
+// libtaiEpoch is 1970-01-01 00:00:10 TAI, the instant of the label
+// 2^62 + 10 with which libtai labels the UNIX time 0
+var libtaiEpoch = tai.Date(1970, 1, 1).Add(10, 0)
+
+// LibtaiLabel returns the instant whose TAI64N label is the one libtai gives
+// t.  libtai, and so daemontools, labels the UNIX time u as 2^62 + 10 + u, as
+// though TAI-UTC were always 10 seconds, rather than labelling the TAI instant
+// of u; the labels of tai64n and multilog are therefore 27 seconds behind the
+// true TAI64N label since 2017.  An instant within a leap second is labelled
+// as the second after it, as its UNIX time is.
+func LibtaiLabel(t tai.TAI) tai.TAI {
+	secs, nsecs := t.Unix()
+	return libtaiEpoch.Add(secs, nsecs*tai.Nanosecond)
+}
+
+// FromLibtaiLabel is the inverse of LibtaiLabel; it returns the instant of a
+// label written by libtai, e.g. as from tai.ParseTAI64NLabel
+func FromLibtaiLabel(l tai.TAI) tai.TAI {
+	secs, asec := l.Parts()
+	epoch, _ := libtaiEpoch.Parts()
+	return tai.UnixAsec(secs-epoch, asec)
+}
+
+// stamper prefixes the lines of a stream with a Stamp and a space
+type stamper struct {
+	stamp   Stamp
+	clock   tai.Clock
+	midLine bool
+}
+
+func newStamper(stamp Stamp, clock tai.Clock) stamper {
+	if clock == nil {
+		clock = tai.DefaultClock
+	}
+	return stamper{stamp: stamp, clock: clock}
+}
+
+// appendStamped appends p to dst, stamping each line that begins in p with now
+func (s *stamper) appendStamped(dst, p []byte, now tai.TAI) []byte {
+	for len(p) > 0 {
+		if !s.midLine {
+			dst = s.stamp.append(dst, now)
+			dst = append(dst, ' ')
+			s.midLine = true
+		}
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			return append(dst, p...)
+		}
+		dst = append(dst, p[:i+1]...)
+		p = p[i+1:]
+		s.midLine = false
+	}
+	return dst
+}
+
+// Writer is an io.Writer that prefixes each line written through it with the
+// time at which its first byte was written.
+type Writer struct {
+	w   io.Writer
+	s   stamper
+	buf []byte
+}
+
+// NewWriter returns a Writer that writes stamped lines to w.  If clock is nil,
+// tai.DefaultClock is used.
+func NewWriter(w io.Writer, stamp Stamp, clock tai.Clock) *Writer {
+	return &Writer{w: w, s: newStamper(stamp, clock)}
+}
+
+// Write writes p to the underlying writer, stamping each line that begins in
+// p.  The clock is read once per call.
+func (w *Writer) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	w.buf = w.s.appendStamped(w.buf[:0], p, w.s.clock.Now())
+	if _, err := w.w.Write(w.buf); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Reader is an io.Reader that prefixes each line read through it with the
+// time at which its first byte was read from the underlying reader.
+type Reader struct {
+	r   io.Reader
+	s   stamper
+	in  []byte
+	out []byte
+	off int
+	err error
+}
+
+// NewReader returns a Reader that stamps the lines read from r.  If clock is
+// nil, tai.DefaultClock is used.
+func NewReader(r io.Reader, stamp Stamp, clock tai.Clock) *Reader {
+	return &Reader{r: r, s: newStamper(stamp, clock), in: make([]byte, 4096)}
+}
+
+// Read reads stamped data into p
+func (r *Reader) Read(p []byte) (int, error) {
+	for r.off == len(r.out) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		n, err := r.r.Read(r.in)
+		r.out = r.s.appendStamped(r.out[:0], r.in[:n], r.s.clock.Now())
+		r.off = 0
+		r.err = err
+	}
+	n := copy(p, r.out[r.off:])
+	r.off += n
+	return n, nil
+}
@@ -0,0 +1,92 @@
+package taiio_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/brandondube/tai"
+	"github.com/brandondube/tai/taiio"
+)
+
+// stepClock advances by one second each time it is read
+type stepClock struct {
+	now tai.TAI
+}
+
+func (c *stepClock) Now() tai.TAI {
+	t := c.now
+	c.now = c.now.Add(1, 0)
+	return t
+}
+
+func TestWriter(t *testing.T) {
+	clock := &stepClock{now: tai.Date(2024, 7, 1)}
+	var buf bytes.Buffer
+	w := taiio.NewWriter(&buf, taiio.RFC3339Nano, clock)
+	// a line split across writes keeps the stamp of its first byte
+	for _, s := range []string{"one\ntw", "o\n", "three\nfour\n"} {
+		if n, err := w.Write([]byte(s)); err != nil || n != len(s) {
+			t.Fatalf("Write(%q) = %d, %v", s, n, err)
+		}
+	}
+	exp := "2024-07-01T00:00:00.000000000Z one\n" +
+		"2024-07-01T00:00:00.000000000Z two\n" +
+		"2024-07-01T00:00:02.000000000Z three\n" +
+		"2024-07-01T00:00:02.000000000Z four\n"
+	if buf.String() != exp {
+		t.Fatalf("expected\n%s\ngot\n%s", exp, buf.String())
+	}
+}
+
+func TestWriterTAI64N(t *testing.T) {
+	clock := &stepClock{now: tai.Date(1970, 1, 1).Add(1, 500*tai.Nanosecond)}
+	var buf bytes.Buffer
+	w := taiio.NewWriter(&buf, taiio.TAI64N, clock)
+	w.Write([]byte("hello\n"))
+	if exp := "@4000000000000001000001f4 hello\n"; buf.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, buf.String())
+	}
+}
+
+func TestReader(t *testing.T) {
+	clock := &stepClock{now: tai.Date(2024, 7, 1)}
+	r := taiio.NewReader(strings.NewReader("a\nb\nunterminated"), taiio.RFC3339Nano, clock)
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := "2024-07-01T00:00:00.000000000Z a\n" +
+		"2024-07-01T00:00:00.000000000Z b\n" +
+		"2024-07-01T00:00:00.000000000Z unterminated"
+	if string(out) != exp {
+		t.Fatalf("expected\n%s\ngot\n%s", exp, out)
+	}
+}
+
+func TestWriterLibtaiTAI64N(t *testing.T) {
+	// libtai labels the UNIX time 1 as 2^62 + 10 + 1
+	clock := &stepClock{now: tai.Unix(1, 500)}
+	var buf bytes.Buffer
+	w := taiio.NewWriter(&buf, taiio.LibtaiTAI64N, clock)
+	w.Write([]byte("hello\n"))
+	if exp := "@400000000000000b000001f4 hello\n"; buf.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, buf.String())
+	}
+}
+
+func TestLibtaiLabel(t *testing.T) {
+	for _, ta := range []tai.TAI{tai.Unix(0, 0), tai.Unix(1719837296, 123456789), tai.Date(1900, 1, 1)} {
+		l := taiio.LibtaiLabel(ta)
+		if got := taiio.FromLibtaiLabel(l); !got.Eq(ta) {
+			t.Errorf("expected %+v, got %+v", ta.AsGregorian(), got.AsGregorian())
+		}
+		// 27 seconds behind the true label since 2017
+		if ta.After(tai.Date(2017, 1, 1)) {
+			if d, _ := ta.Sub(l).Parts(); d != 27 {
+				t.Errorf("expected the libtai label 27 s behind, got %d s", d)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Package taiprom exposes the state of the pkg tai leap second table as
+// metrics in the Prometheus text exposition format, so that deployments with
+// a stale table can be found and alerted on.
+//
+// The metrics are:
+//
+//	tai_leap_table_info{hash="..."}            1, labeled with tai.LeapTableHash
+//	tai_leap_table_entries                     number of entries in the table
+//	tai_leap_table_last_leap_timestamp_seconds UNIX time of the newest entry
+//	tai_utc_offset_seconds                     TAI-UTC at the time of the scrape
+package taiprom
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/brandondube/tai"
+)
+
+// WriteMetrics writes the leap second table metrics at the moment now to w
+func WriteMetrics(w io.Writer, now tai.TAI) error {
+	table := tai.LeapSeconds()
+	var last int64
+	if len(table) > 0 {
+		last = table[len(table)-1].UnixUTC
+	}
+	unix, _ := now.Unix()
+	nowSec, _ := now.Parts()
+	epoch, _ := tai.Date(1970, 1, 1).Parts()
+	offset := nowSec - epoch - unix
+
+	bw := bufio.NewWriter(w)
+	gauge(bw, "tai_leap_table_info", "Identifier of the leap second table.", fmt.Sprintf("{hash=%q}", tai.LeapTableHash()), 1)
+	gauge(bw, "tai_leap_table_entries", "Number of entries in the leap second table.", "", int64(len(table)))
+	gauge(bw, "tai_leap_table_last_leap_timestamp_seconds", "UNIX time of the newest entry of the leap second table.", "", last)
+	gauge(bw, "tai_utc_offset_seconds", "Offset of TAI ahead of UTC.", "", offset)
+	return bw.Flush()
+}
+
+func gauge(w io.Writer, name, help, labels string, v int64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s%s %d\n", name, help, name, name, labels, v)
+}
+
+// Handler is an http.Handler serving the leap second table metrics, for
+// mounting at e.g. /metrics or alongside another registry's output.
+type Handler struct {
+	// Clock is the source of the scrape time; nil is tai.DefaultClock
+	Clock tai.Clock
+}
+
+// ServeHTTP implements http.Handler
+func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	clock := h.Clock
+	if clock == nil {
+		clock = tai.DefaultClock
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	WriteMetrics(w, clock.Now())
+}
@@ -0,0 +1,49 @@
+package taiprom_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+	"github.com/brandondube/tai/taiprom"
+)
+
+type fixedClock tai.TAI
+
+func (c fixedClock) Now() tai.TAI { return tai.TAI(c) }
+
+func TestWriteMetrics(t *testing.T) {
+	now := tai.FromTime(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))
+	var buf bytes.Buffer
+	if err := taiprom.WriteMetrics(&buf, now); err != nil {
+		t.Fatal(err)
+	}
+	table := tai.LeapSeconds()
+	out := buf.String()
+	for _, line := range []string{
+		"# TYPE tai_leap_table_info gauge",
+		fmt.Sprintf("tai_leap_table_info{hash=%q} 1", tai.LeapTableHash()),
+		fmt.Sprintf("tai_leap_table_entries %d", len(table)),
+		fmt.Sprintf("tai_leap_table_last_leap_timestamp_seconds %d", table[len(table)-1].UnixUTC),
+		fmt.Sprintf("tai_utc_offset_seconds %d", table[len(table)-1].CumulativeSkew),
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("expected a line %q in\n%s", line, out)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	taiprom.Handler{Clock: fixedClock(tai.Date(2024, 7, 1))}.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Fatalf("unexpected Content-Type %q", ct)
+	}
+	if !strings.Contains(rec.Body.String(), "tai_utc_offset_seconds ") {
+		t.Fatalf("expected the offset metric, got\n%s", rec.Body.String())
+	}
+}
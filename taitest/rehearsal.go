@@ -0,0 +1,149 @@
+package taitest
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+// Rehearsal is a simulated clock that crosses a synthetic leap second, so that
+// the leap night behavior of a service may be rehearsed in CI.  The leap
+// second is added to a copy of the leap second table (see func
+// tai.Converter.WithLeapSecond) and the global table is not changed.
+//
+// a Rehearsal is a tai.Scheduler.  Once installed as the clock of pkg tai by
+// Install, tai.Timer, tai.AfterFunc, tai.Ticker, and the types built on them
+// run by the simulated time, as do tai.Limiter, tai.Backoff, and similar that
+// read tai.DefaultClock.  Time passes only when Advance is called.  A
+// Rehearsal is safe for concurrent use.
+type Rehearsal struct {
+	// Converter has the leap second table with the synthetic leap second, for
+	// the conversion of the simulated time to and from UTC
+	Converter *tai.Converter
+	// Leap is the UNIX time of the synthetic leap second
+	Leap int64
+
+	mu     sync.Mutex
+	now    tai.TAI
+	timers []*timer // in order of deadline, then creation
+	seq    int
+}
+
+// NewRehearsal returns a Rehearsal of a leap second at the UNIX time unixUTC
+// that changes TAI-UTC by step (1 to insert a second, -1 to omit one), with
+// the simulated clock starting lead before unixUTC, the end of the leap
+// second.  unixUTC must be after the last leap second of the table; see func
+// NextLeapDate.
+func NewRehearsal(unixUTC, step int64, lead tai.Duration) (*Rehearsal, error) {
+	c, err := tai.Snapshot().WithLeapSecond(unixUTC, step)
+	if err != nil {
+		return nil, err
+	}
+	start := c.FromUnix(unixUTC, 0).AddDuration(lead.Neg())
+	return &Rehearsal{Converter: c, Leap: unixUTC, now: start}, nil
+}
+
+// NextLeapDate returns the UNIX time of the first date after both t and the
+// last leap second of the table on which a leap second may occur, the start
+// of January 1 or July 1 UTC
+func NextLeapDate(t tai.TAI) int64 {
+	table := tai.LeapSeconds()
+	s, _ := t.Unix()
+	if last := table[len(table)-1].UnixUTC; last > s {
+		s = last
+	}
+	u := time.Unix(s, 0).UTC()
+	next := time.Date(u.Year(), time.July, 1, 0, 0, 0, 0, time.UTC)
+	if !next.After(u) {
+		next = time.Date(u.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return next.Unix()
+}
+
+// Now returns the simulated time
+func (r *Rehearsal) Now() tai.TAI {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.now
+}
+
+// UTC returns the simulated time as UTC, converted with the table containing
+// the synthetic leap second.  During an inserted leap second, UTC repeats the
+// first second of the next day, as func tai.TAI.AsTime.
+func (r *Rehearsal) UTC() time.Time {
+	return r.Converter.AsTime(r.Now())
+}
+
+// Install makes r the clock of pkg tai, as by tai.WithClock, and returns a
+// function that restores the previous clock
+func (r *Rehearsal) Install() (restore func()) {
+	prev := tai.GetConfig().Clock
+	tai.Configure(tai.WithClock(r))
+	return func() {
+		tai.Configure(tai.WithClock(prev))
+	}
+}
+
+// Advance moves the simulated time forward by d, calling each function
+// scheduled that becomes due in the order of their deadlines.  The simulated
+// time is the deadline of each as it is called, and the functions may
+// schedule or cancel others.
+func (r *Rehearsal) Advance(d tai.Duration) {
+	r.mu.Lock()
+	end := r.now.AddDuration(d)
+	for len(r.timers) > 0 && !r.timers[0].deadline.After(end) {
+		t := r.timers[0]
+		r.timers = r.timers[1:]
+		if t.deadline.After(r.now) {
+			r.now = t.deadline
+		}
+		r.mu.Unlock()
+		t.f()
+		r.mu.Lock()
+	}
+	if end.After(r.now) {
+		r.now = end
+	}
+	r.mu.Unlock()
+}
+
+// timer is a function scheduled by a Rehearsal
+type timer struct {
+	deadline tai.TAI
+	seq      int
+	f        func()
+}
+
+// Schedule calls f from Advance once the simulated time reaches deadline, and
+// returns a function that cancels the call, reporting whether it was pending
+func (r *Rehearsal) Schedule(deadline tai.TAI, f func()) (cancel func() bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	t := &timer{deadline: deadline, seq: r.seq, f: f}
+	r.insert(t)
+	return func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for i, o := range r.timers {
+			if o == t {
+				r.timers = append(r.timers[:i], r.timers[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// insert adds t to the timers in order; r.mu must be held
+func (r *Rehearsal) insert(t *timer) {
+	i := sort.Search(len(r.timers), func(i int) bool {
+		o := r.timers[i]
+		return o.deadline.After(t.deadline) || (o.deadline.Eq(t.deadline) && o.seq > t.seq)
+	})
+	r.timers = append(r.timers, nil)
+	copy(r.timers[i+1:], r.timers[i:])
+	r.timers[i] = t
+}
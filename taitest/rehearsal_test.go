@@ -0,0 +1,113 @@
+package taitest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+	"github.com/brandondube/tai/taitest"
+)
+
+func TestRehearsalTicksAcrossLeap(t *testing.T) {
+	leap := taitest.NextLeapDate(tai.Now())
+	cases := []struct {
+		descr string
+		step  int64
+		exp   []int64 // UNIX seconds read at each tick, relative to the leap
+	}{
+		{"Positive", 1, []int64{-1, 0, 0}},
+		{"Negative", -1, []int64{-3, -2, 0}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			before := len(tai.LeapSeconds())
+			r, err := taitest.NewRehearsal(leap, tc.step, tai.Dur(3, 0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := len(tai.LeapSeconds()); n != before {
+				t.Fatalf("expected the global table to be unchanged, it has %d entries, not %d", n, before)
+			}
+			defer r.Install()()
+			ticker := tai.NewTicker(tai.Dur(1, 0))
+			defer ticker.Stop()
+			var got []int64
+			for i := 0; i < 3; i++ {
+				r.Advance(tai.Dur(1, 0))
+				got = append(got, r.Converter.AsTime(<-ticker.C).Unix()-leap)
+			}
+			for i := range got {
+				if got[i] != tc.exp[i] {
+					t.Fatalf("expected %v, got %v", tc.exp, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRehearsalTimers(t *testing.T) {
+	leap := taitest.NextLeapDate(tai.Now())
+	r, err := taitest.NewRehearsal(leap, 1, tai.Dur(10, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Install()()
+	start := r.Now()
+	var order []string
+	tai.AfterFunc(r.Converter.FromTime(time.Unix(leap, 0)), func() { order = append(order, "utc") })
+	tai.AfterFunc(start.Add(9, 0), func() { order = append(order, "tai") })
+	stopped := tai.AfterFunc(start.Add(1, 0), func() { order = append(order, "stopped") })
+	if !stopped.Stop() || stopped.Stop() {
+		t.Fatal("expected Stop to report true once")
+	}
+	reset := tai.AfterFunc(start.Add(2, 0), func() { order = append(order, "reset") })
+	if !reset.Reset(start.Add(20, 0)) {
+		t.Fatal("expected Reset to report an active timer")
+	}
+	r.Advance(tai.Dur(9, 5e17))
+	if len(order) != 1 || order[0] != "tai" {
+		t.Fatalf("expected only the TAI timer to fire within the inserted second, got %v", order)
+	}
+	r.Advance(tai.Dur(0, 5e17))
+	if len(order) != 2 || order[1] != "utc" {
+		t.Fatalf("expected the UTC timer to fire after the inserted second, got %v", order)
+	}
+	if exp := start.Add(10, 0); !r.Now().Eq(exp) {
+		t.Fatalf("expected the simulated time to be %+v, got %+v", exp, r.Now())
+	}
+	r.Advance(tai.Dur(10, 0))
+	if len(order) != 3 || order[2] != "reset" {
+		t.Fatalf("expected the reset timer to fire at its new deadline, got %v", order)
+	}
+}
+
+func TestRehearsalWatchdog(t *testing.T) {
+	r, err := taitest.NewRehearsal(taitest.NextLeapDate(tai.Now()), 1, tai.Dur(5, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Install()()
+	expired := 0
+	w := tai.NewWatchdog(tai.Dur(3, 0), func() { expired++ })
+	w.Feed(r.Now())
+	r.Advance(tai.Dur(2, 0))
+	w.Feed(r.Now())
+	r.Advance(tai.Dur(2, 0))
+	if expired != 0 {
+		t.Fatal("expected the fed watchdog not to expire")
+	}
+	r.Advance(tai.Dur(1, 0))
+	if expired != 1 {
+		t.Fatalf("expected the watchdog to expire across the leap second, got %d expiries", expired)
+	}
+}
+
+func TestNewRehearsalInvalid(t *testing.T) {
+	table := tai.LeapSeconds()
+	if _, err := taitest.NewRehearsal(table[len(table)-1].UnixUTC, 1, tai.Dur(1, 0)); err == nil {
+		t.Fatal("expected an error for a leap second already in the table")
+	}
+	if _, err := taitest.NewRehearsal(taitest.NextLeapDate(tai.Now()), 2, tai.Dur(1, 0)); err == nil {
+		t.Fatal("expected an error for a step of 2")
+	}
+}
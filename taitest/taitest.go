@@ -0,0 +1,48 @@
+// Package taitest provides utilities for testing and simulating code that
+// uses pkg tai.
+package taitest
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/brandondube/tai"
+)
+
+// RandomTAI returns an instant drawn uniformly at attosecond resolution from
+// within.  The sequence of results is determined by r, so that a failing test
+// or simulation can be reproduced from its seed.
+//
+// RandomTAI panics if within is empty.
+func RandomTAI(r *rand.Rand, within tai.Interval) tai.TAI {
+	if within.Empty() {
+		panic("taitest.RandomTAI: empty interval")
+	}
+	spanSec, spanAsec := within.Length().Parts()
+	for {
+		var sec, asec int64
+		if spanSec > 0 {
+			// draw the whole second from [0, spanSec] and reject draws that
+			// land past the end within the final, partial second
+			if spanSec == math.MaxInt64 {
+				sec = int64(r.Uint64() >> 1)
+			} else {
+				sec = r.Int63n(spanSec + 1)
+			}
+			asec = r.Int63n(1e18)
+		} else {
+			asec = r.Int63n(spanAsec)
+		}
+		if sec == spanSec && asec >= spanAsec {
+			continue
+		}
+		return within.Start.AddDuration(tai.Dur(sec, asec))
+	}
+}
+
+// RandomGregorian returns the Gregorian breakdown of RandomTAI(r, within).
+// The result always satisfies the validity rules of the calendar, including
+// the lengths of months and leap years.
+func RandomGregorian(r *rand.Rand, within tai.Interval) tai.Gregorian {
+	return RandomTAI(r, within).AsGregorian()
+}
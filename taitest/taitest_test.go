@@ -0,0 +1,63 @@
+package taitest_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/brandondube/tai"
+	"github.com/brandondube/tai/taitest"
+)
+
+func TestRandomTAIWithin(t *testing.T) {
+	cases := []struct {
+		descr  string
+		within tai.Interval
+	}{
+		{"Century", tai.Interval{Start: tai.Date(1950, 1, 1), End: tai.Date(2050, 1, 1)}},
+		{"SubSecond", tai.Interval{Start: tai.Date(2024, 1, 1), End: tai.Date(2024, 1, 1).Add(0, 1000)}},
+		{"JustOverASecond", tai.Interval{Start: tai.Tai(-1, 0), End: tai.Tai(0, 5)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 1000; i++ {
+				if ta := taitest.RandomTAI(r, tc.within); !tc.within.Contains(ta) {
+					t.Fatalf("%+v is outside of %+v", ta, tc.within)
+				}
+			}
+		})
+	}
+}
+
+func TestRandomTAIDeterministic(t *testing.T) {
+	within := tai.Interval{Start: tai.Date(2000, 1, 1), End: tai.Date(2100, 1, 1)}
+	a, b := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42))
+	for i := 0; i < 10; i++ {
+		if !taitest.RandomTAI(a, within).Eq(taitest.RandomTAI(b, within)) {
+			t.Fatal("expected the same sequence from the same seed")
+		}
+	}
+}
+
+func TestRandomGregorianValid(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	within := tai.Interval{Start: tai.Date(-400, 1, 1), End: tai.Date(2400, 1, 1)}
+	for i := 0; i < 1000; i++ {
+		g := taitest.RandomGregorian(r, within)
+		if !g.Month.Valid() || g.Day < 1 || g.Day > tai.DaysInMonth(int(g.Month), g.Year) {
+			t.Fatalf("invalid date %+v", g)
+		}
+		if g.Hour > 23 || g.Min > 59 || g.Sec > 59 || g.Asec < 0 || g.Asec >= 1e18 {
+			t.Fatalf("invalid time of day %+v", g)
+		}
+	}
+}
+
+func TestRandomTAIEmptyPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for an empty interval")
+		}
+	}()
+	taitest.RandomTAI(rand.New(rand.NewSource(1)), tai.Interval{})
+}
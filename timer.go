@@ -0,0 +1,120 @@
+package tai
+
+import (
+	"sync"
+	"time"
+)
+
+// Scheduler is a Clock that also runs timers by its own time, such as a
+// simulated clock.  If Config.Clock is a Scheduler, Timer and Ticker are
+// scheduled by it rather than by the stdlib timer.
+type Scheduler interface {
+	Clock
+	// Schedule calls f once the clock reaches deadline, and returns a
+	// function that cancels the call, reporting whether it was pending
+	Schedule(deadline TAI, f func()) (cancel func() bool)
+}
+
+// Timer calls a function once a TAI deadline is reached.
+//
+// the wait is performed by the stdlib timer, which measures elapsed time with
+// the host's monotonic clock; the deadline is converted to a relative delay
+// against DefaultClock when the Timer is started or reset.  If Config.Clock
+// is a Scheduler when the Timer is started, the wait is performed by it
+// instead.
+type Timer struct {
+	t *time.Timer
+
+	mu     sync.Mutex
+	s      Scheduler
+	f      func()
+	cancel func() bool
+}
+
+// AfterFunc waits until deadline and then calls f in its own goroutine.  If
+// the deadline has already passed, f is called immediately.  f is called as
+// the Scheduler calls it if Config.Clock is one.
+func AfterFunc(deadline TAI, f func()) *Timer {
+	if s, ok := currentConfig().Clock.(Scheduler); ok {
+		return &Timer{s: s, f: f, cancel: s.Schedule(deadline, f)}
+	}
+	return &Timer{t: time.AfterFunc(TTL(DefaultClock.Now(), deadline), f)}
+}
+
+// Stop prevents the Timer from firing.  It returns true if the call stops the
+// timer, false if the timer has already fired or been stopped.
+func (t *Timer) Stop() bool {
+	if t.t != nil {
+		return t.t.Stop()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.cancel()
+}
+
+// Reset changes the timer to fire at deadline.  It returns true if the timer
+// had been active.
+func (t *Timer) Reset(deadline TAI) bool {
+	if t.t != nil {
+		return t.t.Reset(TTL(DefaultClock.Now(), deadline))
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	active := t.cancel()
+	t.cancel = t.s.Schedule(deadline, t.f)
+	return active
+}
+
+// Ticker delivers the time of DefaultClock on C every period of TAI.  The
+// ticks are at whole periods from the time the Ticker is started, so they do
+// not drift; ticks are dropped if the receiver falls behind, as by
+// time.Ticker.  The ticks are scheduled by a Timer.
+type Ticker struct {
+	// C is the channel on which the ticks are delivered
+	C <-chan TAI
+
+	c       chan TAI
+	period  Duration
+	mu      sync.Mutex
+	next    TAI
+	timer   *Timer
+	stopped bool
+}
+
+// NewTicker returns a Ticker of the given period.  NewTicker panics if period
+// is not positive.
+func NewTicker(period Duration) *Ticker {
+	if !(Duration{}).Less(period) {
+		panic("tai.NewTicker: period must be positive")
+	}
+	c := make(chan TAI, 1)
+	t := &Ticker{C: c, c: c, period: period}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.next = DefaultClock.Now().AddDuration(period)
+	t.timer = AfterFunc(t.next, t.tick)
+	return t
+}
+
+func (t *Ticker) tick() {
+	select {
+	case t.c <- DefaultClock.Now():
+	default:
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped {
+		return
+	}
+	t.next = t.next.AddDuration(t.period)
+	t.timer.Reset(t.next)
+}
+
+// Stop turns off the Ticker.  No more ticks are delivered, and C is not
+// closed.
+func (t *Ticker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stopped = true
+	t.timer.Stop()
+}
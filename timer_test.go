@@ -0,0 +1,68 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestAfterFuncFires(t *testing.T) {
+	done := make(chan struct{})
+	tai.AfterFunc(tai.Now().Add(0, 10*tai.Millisecond), func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not fire")
+	}
+}
+
+func TestTimerStop(t *testing.T) {
+	timer := tai.AfterFunc(tai.Now().AddHMS(1, 0, 0), func() { t.Error("stopped timer fired") })
+	if !timer.Stop() {
+		t.Fatal("expected Stop to report an active timer")
+	}
+}
+
+func TestAfterFuncFollowsDefaultClock(t *testing.T) {
+	defer restoreConfig(t)
+	// an hour ahead of the host, so that a delay measured against SystemClock
+	// would not elapse during the test
+	clock := &fakeClock{now: tai.Now().AddHMS(1, 0, 0)}
+	if err := tai.Configure(tai.WithClock(clock)); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	tai.AfterFunc(clock.now.Add(0, 10*tai.Millisecond), func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer did not fire by the configured clock")
+	}
+}
+
+func TestTicker(t *testing.T) {
+	ticker := tai.NewTicker(tai.Dur(0, 10*tai.Millisecond))
+	defer ticker.Stop()
+	var last tai.TAI
+	for i := 0; i < 2; i++ {
+		select {
+		case now := <-ticker.C:
+			if !last.Before(now) {
+				t.Fatalf("expected tick %d after %+v, got %+v", i, last, now)
+			}
+			last = now
+		case <-time.After(5 * time.Second):
+			t.Fatalf("tick %d was not delivered", i)
+		}
+	}
+}
+
+func TestNewTickerInvalidPeriod(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for a zero period")
+		}
+	}()
+	tai.NewTicker(tai.Duration{})
+}
@@ -0,0 +1,94 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package tai
+
+import (
+	"errors"
+	"math"
+	"syscall"
+)
+
+// ErrKernelTimeRange is returned when converting an instant which does not fit
+// in a syscall.Timespec or Timeval.  Their nanosecond conversions limit them to
+// the years 1678 to 2262, and a 32-bit time_t to 1901 to 2038.
+var ErrKernelTimeRange = errors.New("tai: time out of range of the kernel time structure")
+
+// FromTimespec returns the TAI time of ts, a UNIX time such as of
+// CLOCK_REALTIME.  The leap second table is consulted in making the
+// conversion; see func Unix.
+func FromTimespec(ts syscall.Timespec) TAI {
+	return Unix(ts.Unix())
+}
+
+// FromTimeval is FromTimespec for a syscall.Timeval
+func FromTimeval(tv syscall.Timeval) TAI {
+	return Unix(tv.Unix())
+}
+
+// FromTimespecTAI returns the TAI time of ts, a reading of CLOCK_TAI, which
+// counts TAI seconds since 1970-01-01 in the TAI calendar.  It is exact, as no
+// leap seconds intervene.
+func FromTimespecTAI(ts syscall.Timespec) TAI {
+	s, ns := ts.Unix()
+	return Tai(s+unixEpochSkew, ns*Nanosecond)
+}
+
+// FromTimevalTAI is FromTimespecTAI for a syscall.Timeval
+func FromTimevalTAI(tv syscall.Timeval) TAI {
+	s, ns := tv.Unix()
+	return Tai(s+unixEpochSkew, ns*Nanosecond)
+}
+
+// Timespec returns t as a UNIX time in a syscall.Timespec, as of
+// CLOCK_REALTIME; it is the inverse of FromTimespec
+func (t TAI) Timespec() (syscall.Timespec, error) {
+	return timespec(t.Unix())
+}
+
+// Timeval is Timespec for a syscall.Timeval, truncated to the microsecond
+func (t TAI) Timeval() (syscall.Timeval, error) {
+	return timeval(t.Unix())
+}
+
+// TimespecTAI returns t as a reading of CLOCK_TAI; it is the inverse of
+// FromTimespecTAI
+func (t TAI) TimespecTAI() (syscall.Timespec, error) {
+	return timespec(t.sec-unixEpochSkew, t.asec/Nanosecond)
+}
+
+// TimevalTAI is TimespecTAI for a syscall.Timeval, truncated to the
+// microsecond
+func (t TAI) TimevalTAI() (syscall.Timeval, error) {
+	return timeval(t.sec-unixEpochSkew, t.asec/Nanosecond)
+}
+
+// maxNanoSecs bounds the seconds of a time in nanoseconds in an int64
+const maxNanoSecs = math.MaxInt64 / 1000000000
+
+// timespec returns the Timespec of secs and nsecs, for 0 <= nsecs < 1e9
+func timespec(secs, nsecs int64) (syscall.Timespec, error) {
+	if secs >= maxNanoSecs || secs <= -maxNanoSecs {
+		return syscall.Timespec{}, ErrKernelTimeRange
+	}
+	ts := syscall.NsecToTimespec(secs*1e9 + nsecs)
+	// time_t is 32 bits on some platforms
+	if s, _ := ts.Unix(); s != secs {
+		return syscall.Timespec{}, ErrKernelTimeRange
+	}
+	return ts, nil
+}
+
+// timeval is timespec for a Timeval
+func timeval(secs, nsecs int64) (syscall.Timeval, error) {
+	if secs >= maxNanoSecs || secs <= -maxNanoSecs {
+		return syscall.Timeval{}, ErrKernelTimeRange
+	}
+	// NsecToTimeval rounds up to the microsecond, which is undone so that it
+	// truncates, as elsewhere
+	tv := syscall.NsecToTimeval(secs*1e9 + nsecs/1e3*1e3 - 999)
+	if s, _ := tv.Unix(); s != secs {
+		return syscall.Timeval{}, ErrKernelTimeRange
+	}
+	return tv, nil
+}
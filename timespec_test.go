@@ -0,0 +1,79 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package tai_test
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestTimespec(t *testing.T) {
+	cases := []struct {
+		descr     string
+		sec, nsec int64
+	}{
+		{"Recent", 1719837296, 123456789},
+		{"BeforeEpoch", -1, 999999000},
+		{"Epoch", 0, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			ta := tai.Unix(tc.sec, tc.nsec)
+			ts, err := ta.Timespec()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s, ns := ts.Unix(); s != tc.sec || ns != tc.nsec {
+				t.Fatalf("expected %d.%09d, got %d.%09d", tc.sec, tc.nsec, s, ns)
+			}
+			if back := tai.FromTimespec(ts); !back.Eq(ta) {
+				t.Fatalf("expected %+v, got %+v", ta, back)
+			}
+			tv, err := ta.Timeval()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s, ns := tv.Unix(); s != tc.sec || ns != tc.nsec/1000*1000 {
+				t.Fatalf("expected the Timeval %d.%06d, got %d.%09d", tc.sec, tc.nsec/1000, s, ns)
+			}
+			if back := tai.FromTimeval(tv); !back.Eq(tai.Unix(tc.sec, tc.nsec/1000*1000)) {
+				t.Fatalf("expected the Timeval to round trip, got %+v", back)
+			}
+		})
+	}
+}
+
+func TestTimespecTAI(t *testing.T) {
+	// CLOCK_TAI leads CLOCK_REALTIME by TAI-UTC, 37 s since 2017
+	ta := tai.Unix(1719837296, 250)
+	ts, err := ta.TimespecTAI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ns := ts.Unix(); s != 1719837296+37 || ns != 250 {
+		t.Fatalf("expected %d.%09d, got %d.%09d", int64(1719837296+37), 250, s, ns)
+	}
+	if back := tai.FromTimespecTAI(ts); !back.Eq(ta) {
+		t.Fatalf("expected %+v, got %+v", ta, back)
+	}
+	tv, err := ta.TimevalTAI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back := tai.FromTimevalTAI(tv); !back.Eq(ta.Add(0, -250*tai.Nanosecond)) {
+		t.Fatalf("expected the Timeval to truncate to the microsecond, got %+v", back)
+	}
+	if _, err := tai.Tai(1<<62, 0).Timespec(); err != tai.ErrKernelTimeRange {
+		t.Fatalf("expected ErrKernelTimeRange, got %v", err)
+	}
+	if _, err := tai.Tai(1<<62, 0).TimevalTAI(); err != tai.ErrKernelTimeRange {
+		t.Fatalf("expected ErrKernelTimeRange, got %v", err)
+	}
+	var zero syscall.Timespec
+	if got := tai.FromTimespecTAI(zero); !got.Eq(tai.Date(1970, 1, 1)) {
+		t.Fatalf("expected CLOCK_TAI's epoch to be 1970-01-01 TAI, got %+v", got)
+	}
+}
@@ -0,0 +1,36 @@
+package tai
+
+// TOTPCounter returns the RFC 6238 time-step counter of t, floor((unix - t0) /
+// step), where unix is the UNIX time of t and t0 and step are in seconds.  The
+// RFC's defaults are a t0 of zero and a step of 30.
+//
+// the UNIX time is found with the leap second table, as the RFC counts UNIX
+// seconds; an inserted leap second has the UNIX time of the second after it,
+// and so is counted in that second's step.
+//
+// TOTPCounter panics if step is not positive.
+func TOTPCounter(t TAI, t0, step int64) int64 {
+	if step <= 0 {
+		panic("tai.TOTPCounter: step must be positive")
+	}
+	secs, _ := t.unix()
+	q, _ := floorDiv(secs-t0, step)
+	return q
+}
+
+// TOTPInterval returns the span of TAI time in which the RFC 6238 time-step
+// counter is counter; see func TOTPCounter
+func TOTPInterval(counter, t0, step int64) Interval {
+	start := t0 + counter*step
+	return Interval{Start: unixBoundary(start), End: unixBoundary(start + step)}
+}
+
+// unixBoundary returns the first TAI moment with UNIX time s: the beginning
+// of the leap second inserted before s, if there is one
+func unixBoundary(s int64) TAI {
+	t := unixAsec(s, 0)
+	if prev := unixAsec(s-1, 0).Add(1, 0); prev.Before(t) {
+		return prev
+	}
+	return t
+}
@@ -0,0 +1,46 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestTOTPCounter(t *testing.T) {
+	// the test vectors of RFC 6238 appendix B
+	cases := []struct {
+		unix int64
+		exp  int64
+	}{
+		{59, 0x1},
+		{1111111109, 0x23523EC},
+		{1111111111, 0x23523ED},
+		{1234567890, 0x273EF07},
+		{2000000000, 0x3F940AA},
+		{20000000000, 0x27BC86AA},
+	}
+	for _, tc := range cases {
+		if got := tai.TOTPCounter(tai.Unix(tc.unix, 0), 0, 30); got != tc.exp {
+			t.Errorf("unix %d: expected counter %#x, got %#x", tc.unix, tc.exp, got)
+		}
+	}
+}
+
+func TestTOTPCounterLeapSecond(t *testing.T) {
+	after := tai.Unix(leap2017, 0)
+	during := after.Add(-1, 0)
+	k := tai.TOTPCounter(after, 0, 30)
+	if got := tai.TOTPCounter(during, 0, 30); got != k {
+		t.Errorf("expected the leap second in counter %d, got %d", k, got)
+	}
+	iv := tai.TOTPInterval(k, 0, 30)
+	if !iv.Start.Eq(during) {
+		t.Errorf("expected the interval of counter %d to begin at %+v, got %+v", k, during, iv.Start)
+	}
+	if !iv.Length().Eq(tai.Dur(31, 0)) {
+		t.Errorf("expected the step containing the leap second to last 31 s, got %+v", iv.Length())
+	}
+	if prev := tai.TOTPInterval(k-1, 0, 30); !prev.End.Eq(during) || !prev.Length().Eq(tai.Dur(30, 0)) {
+		t.Errorf("expected the previous step to end at the leap second, got %+v", prev)
+	}
+}
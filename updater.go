@@ -0,0 +1,165 @@
+package tai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultUpdateInterval is the interval of an AutoUpdater with none given.
+// The IERS announces leap seconds about six months in advance, so a day is
+// frequent enough.
+const DefaultUpdateInterval = 24 * time.Hour
+
+// maxLeapFileSize bounds the body read from an update URL; leap-seconds.list
+// is about 10 kB
+const maxLeapFileSize = 1 << 20
+
+// AutoUpdater periodically fetches a leap-seconds.list file over HTTPS and
+// merges it into the leap second table as by LoadLeapSeconds, so that a
+// long-running process does not drift after the next Bulletin C.  A file which
+// fails to parse, disagrees with the built-in table, or lacks or does not
+// match its hash is rejected and the table left unchanged.
+//
+// the fields must not be changed after Run is called.
+type AutoUpdater struct {
+	// URLs are the sources of the file, tried in order until one succeeds.
+	// If empty, Config.UpdateURLs are used.  Only https URLs are accepted.
+	URLs []string
+	// Interval is the time between updates; if zero, DefaultUpdateInterval
+	Interval time.Duration
+	// Client makes the requests; if nil, http.DefaultClient
+	Client *http.Client
+	// OnUpdate, if not nil, is called after each update with the URL it was
+	// applied from, or the error if every URL failed
+	OnUpdate func(url string, err error)
+
+	mu   sync.Mutex
+	last TAI
+	err  error
+}
+
+// StartAutoUpdate starts an AutoUpdater fetching from url, or from
+// Config.UpdateURLs if url is empty, every interval until ctx is done.  The
+// first update is made immediately.
+func StartAutoUpdate(ctx context.Context, url string, interval time.Duration) *AutoUpdater {
+	u := &AutoUpdater{Interval: interval}
+	if url != "" {
+		u.URLs = []string{url}
+	}
+	go u.Run(ctx)
+	return u
+}
+
+// Run updates the leap second table immediately and then every Interval until
+// ctx is done, and returns ctx.Err()
+func (u *AutoUpdater) Run(ctx context.Context) error {
+	interval := u.Interval
+	if interval <= 0 {
+		interval = DefaultUpdateInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		u.Update(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Update fetches the file from the first URL that provides a valid one and
+// merges it into the leap second table.  If none does, the error of each is
+// returned.
+func (u *AutoUpdater) Update(ctx context.Context) error {
+	urls := u.URLs
+	if len(urls) == 0 {
+		urls = currentConfig().UpdateURLs
+	}
+	var (
+		errs []string
+		from string
+	)
+	for _, s := range urls {
+		if err := u.fetch(ctx, s); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", s, err))
+			continue
+		}
+		from = s
+		break
+	}
+	var err error
+	switch {
+	case len(urls) == 0:
+		err = errors.New("AutoUpdater.Update: no update URLs")
+	case from == "":
+		err = fmt.Errorf("AutoUpdater.Update: %s", strings.Join(errs, "; "))
+	}
+	u.mu.Lock()
+	u.err = err
+	if err == nil {
+		u.last = Now()
+	}
+	f := u.OnUpdate
+	u.mu.Unlock()
+	if f != nil {
+		f(from, err)
+	}
+	return err
+}
+
+// fetch retrieves and applies the file at rawurl
+func (u *AutoUpdater) fetch(ctx context.Context, rawurl string) error {
+	parsed, err := url.Parse(rawurl)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "https" {
+		return errors.New("not an https URL")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawurl, nil)
+	if err != nil {
+		return err
+	}
+	client := u.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %s", resp.Status)
+	}
+	table, expires, err := parseLeapSecondsList(io.LimitReader(resp.Body, maxLeapFileSize), true)
+	if err != nil {
+		return err
+	}
+	return mergeLeaps(table, expires)
+}
+
+// LastUpdate returns the time of the last successful update, and false if
+// there has been none
+func (u *AutoUpdater) LastUpdate() (TAI, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.last, !u.last.IsZero()
+}
+
+// Err returns the error of the most recent update, or nil if it succeeded or
+// none has been made
+func (u *AutoUpdater) Err() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.err
+}
@@ -0,0 +1,109 @@
+package tai_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+// withHash appends the hash of a leap-seconds.list file without one, as NIST
+// computes it
+func withHash(list string) string {
+	var updated, expires, data string
+	for _, l := range strings.Split(list, "\n") {
+		l = strings.TrimSpace(l)
+		switch {
+		case strings.HasPrefix(l, "#$"):
+			updated = strings.TrimSpace(l[2:])
+		case strings.HasPrefix(l, "#@"):
+			expires = strings.TrimSpace(l[2:])
+		case l == "" || l[0] == '#':
+		default:
+			f := strings.Fields(l)
+			data += f[0] + f[1]
+		}
+	}
+	sum := sha1.Sum([]byte(updated + expires + data))
+	return fmt.Sprintf("%s#h\t%x %x %x %x %x\n", list, sum[0:4], sum[4:8], sum[8:12], sum[12:16], sum[16:20])
+}
+
+func TestAutoUpdater(t *testing.T) {
+	embedded, err := ioutil.ReadFile("leap-seconds.list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, l := range strings.Split(string(embedded), "\n") {
+		if !strings.HasPrefix(l, "#h") {
+			lines = append(lines, l)
+		}
+	}
+	stripped := strings.Join(lines, "\n")
+	files := map[string]string{
+		"/newer":    withHash(stripped + "4133980800\t38\t# 1 Jan 2031\n"),
+		"/stripped": stripped,
+		"/tampered": strings.Replace(string(embedded), "3692217600", "3692217601", 1),
+	}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(f))
+	}))
+	defer srv.Close()
+
+	const y2031 = 1924992000
+	n := len(tai.LeapSeconds())
+	u := &tai.AutoUpdater{
+		URLs:   []string{"http://" + strings.TrimPrefix(srv.URL, "https://") + "/newer", srv.URL + "/missing", srv.URL + "/stripped", srv.URL + "/tampered"},
+		Client: srv.Client(),
+	}
+	err = u.Update(context.Background())
+	for _, exp := range []string{"not an https URL", "404", "no hash", "hash does not match"} {
+		if err == nil || !strings.Contains(err.Error(), exp) {
+			t.Fatalf("expected %q in the error, got %v", exp, err)
+		}
+	}
+	if _, ok := u.LastUpdate(); ok || u.Err() != err {
+		t.Fatalf("expected a failed update to be recorded, got %v", u.Err())
+	}
+	if len(tai.LeapSeconds()) != n {
+		t.Fatal("expected the table to be unchanged by failed updates")
+	}
+
+	updates := make(chan string, 1)
+	u = &tai.AutoUpdater{
+		URLs:     []string{srv.URL + "/tampered", srv.URL + "/newer"},
+		Interval: time.Hour,
+		Client:   srv.Client(),
+		OnUpdate: func(url string, err error) { updates <- url },
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- u.Run(ctx) }()
+	if url := <-updates; url != srv.URL+"/newer" {
+		t.Fatalf("expected the update to come from the second URL, got %q", url)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("expected Run to return the context's error, got %v", err)
+	}
+	defer tai.RemoveLeapSecond(y2031)
+	table := tai.LeapSeconds()
+	if l := table[len(table)-1]; l != (tai.LeapSecond{UnixUTC: y2031, CumulativeSkew: 38}) {
+		t.Fatalf("expected the fetched leap second, got %+v", l)
+	}
+	if _, ok := u.LastUpdate(); !ok || u.Err() != nil {
+		t.Fatalf("expected a successful update, got %v", u.Err())
+	}
+}
@@ -0,0 +1,180 @@
+package tai
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// mjdUnixEpoch is the modified Julian date of the UNIX epoch
+const mjdUnixEpoch = 40587
+
+// ErrNoDUT1 is returned when converting an instant outside of the span of the
+// DUT1 table
+var ErrNoDUT1 = errors.New("tai: instant is outside of the DUT1 table")
+
+// DUT1 is a value of UT1-UTC, the difference between the time scale of the
+// Earth's rotation and UTC, as published by the IERS in Bulletin A and its
+// finals data
+type DUT1 struct {
+	// MJD is the modified Julian date of the value, which is at 0h UTC
+	MJD int64
+	// UT1MinusUTC is UT1-UTC, which UTC's leap seconds keep within 0.9 s
+	UT1MinusUTC Duration
+	// Predicted is true if the value is a prediction rather than observed
+	Predicted bool
+}
+
+var (
+	dut1lock sync.RWMutex
+	// dut1 is the DUT1 table, in order of MJD.  It is replaced rather than
+	// modified, so a copy of the slice may be used without the lock.
+	dut1 []DUT1
+)
+
+// RegisterDUT1 adds values to the DUT1 table, replacing any of the same MJD.
+// UT1 is interpolated linearly between the values, which are usually daily.
+//
+// RegisterDUT1 is thread safe with the same guarantees as RegisterLeapSecond.
+func RegisterDUT1(values ...DUT1) {
+	dut1lock.Lock()
+	defer dut1lock.Unlock()
+	byMJD := make(map[int64]DUT1, len(dut1)+len(values))
+	for _, v := range dut1 {
+		byMJD[v.MJD] = v
+	}
+	for _, v := range values {
+		byMJD[v.MJD] = v
+	}
+	next := make([]DUT1, 0, len(byMJD))
+	for _, v := range byMJD {
+		next = append(next, v)
+	}
+	sort.Slice(next, func(i, j int) bool { return next[i].MJD < next[j].MJD })
+	dut1 = next
+}
+
+// ClearDUT1 empties the DUT1 table
+func ClearDUT1() {
+	dut1lock.Lock()
+	defer dut1lock.Unlock()
+	dut1 = nil
+}
+
+// DUT1Table returns a copy of the DUT1 table, in order of MJD
+func DUT1Table() []DUT1 {
+	dut1lock.RLock()
+	defer dut1lock.RUnlock()
+	return append([]DUT1(nil), dut1...)
+}
+
+// UT1 returns t on the UT1 scale, as the time since 1970-01-01 00:00:00 UT1
+// in days of 86400 seconds, like a UNIX time.  ErrNoDUT1 is returned if t is
+// not within the DUT1 table.
+func (t TAI) UT1() (Duration, error) {
+	off, err := ut1MinusTAI(t)
+	if err != nil {
+		return Duration{}, err
+	}
+	return Duration{sec: t.sec - unixEpochSkew, asec: t.asec}.Add(off), nil
+}
+
+// FromUT1 returns the TAI instant of d on the UT1 scale, as returned by
+// func (TAI) UT1
+func FromUT1(d Duration) (TAI, error) {
+	// UT1-TAI changes by milliseconds a day, so the iteration converges in a
+	// few steps
+	t := Tai(d.sec+unixEpochSkew, d.asec)
+	for i := 0; i < 4; i++ {
+		off, err := ut1MinusTAI(t)
+		if err != nil {
+			return TAI{}, err
+		}
+		next := Tai(d.sec+unixEpochSkew, d.asec).AddDuration(off.Neg())
+		if next.Eq(t) {
+			break
+		}
+		t = next
+	}
+	return t, nil
+}
+
+// DUT1At returns UT1-UTC at t, interpolated from the DUT1 table
+func DUT1At(t TAI) (Duration, error) {
+	off, err := ut1MinusTAI(t)
+	if err != nil {
+		return Duration{}, err
+	}
+	secs, _ := t.unix()
+	return off.Add(Dur(skewUnix(secs, UTCToTAI), 0)), nil
+}
+
+// ut1MinusTAI returns UT1-TAI at t, interpolated linearly between the values
+// of the DUT1 table.  UT1-TAI is continuous over leap seconds, where UT1-UTC
+// is not.
+func ut1MinusTAI(t TAI) (Duration, error) {
+	// the conversions, which may fire OnStale callbacks, are made without
+	// the lock
+	dut1lock.RLock()
+	table := dut1
+	dut1lock.RUnlock()
+	i := sort.Search(len(table), func(i int) bool { return dut1At(table[i]).After(t) })
+	switch {
+	case i == 0:
+		return Duration{}, ErrNoDUT1
+	case i == len(table):
+		if last := table[len(table)-1]; dut1At(last).Eq(t) {
+			return dut1MinusTAI(last), nil
+		}
+		return Duration{}, ErrNoDUT1
+	}
+	t0, t1 := dut1At(table[i-1]), dut1At(table[i])
+	a, b := dut1MinusTAI(table[i-1]), dut1MinusTAI(table[i])
+	num := new(big.Int).Mul(b.Sub(a).big(), t.Sub(t0).big())
+	return a.Add(durationFromBig(num.Div(num, t1.Sub(t0).big()))), nil
+}
+
+// dut1At returns the instant of v
+func dut1At(v DUT1) TAI {
+	return Unix((v.MJD-mjdUnixEpoch)*Day, 0)
+}
+
+// dut1MinusTAI returns UT1-TAI at v
+func dut1MinusTAI(v DUT1) Duration {
+	secs := (v.MJD - mjdUnixEpoch) * Day
+	return v.UT1MinusUTC.Add(Dur(-skewUnix(secs, UTCToTAI), 0))
+}
+
+// ParseFinals parses the UT1-UTC values of IERS finals data, such as
+// finals2000A.all or finals.daily, for RegisterDUT1.  Lines without a Bulletin
+// A value of UT1-UTC are skipped.
+func ParseFinals(r io.Reader) ([]DUT1, error) {
+	var out []DUT1
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		// columns 8-15 are the MJD, 58 the flag, and 59-68 UT1-UTC
+		if len(line) < 68 || strings.TrimSpace(line[58:68]) == "" {
+			continue
+		}
+		mjd, err := strconv.ParseFloat(strings.TrimSpace(line[7:15]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("ParseFinals: line %d: invalid MJD", n)
+		}
+		d, err := parseDecimalSeconds(strings.TrimSpace(line[58:68]))
+		if err != nil {
+			return nil, fmt.Errorf("ParseFinals: line %d: invalid UT1-UTC: %w", n, err)
+		}
+		out = append(out, DUT1{MJD: int64(mjd), UT1MinusUTC: d, Predicted: line[57] == 'P'})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("ParseFinals: %w", err)
+	}
+	return out, nil
+}
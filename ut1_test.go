@@ -0,0 +1,84 @@
+package tai_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+// finalsLine returns a line of IERS finals data with the given MJD and
+// Bulletin A UT1-UTC
+func finalsLine(date, mjd string, flag byte, dut1 string) string {
+	b := []byte(strings.Repeat(" ", 68))
+	copy(b, date)
+	copy(b[7:], mjd)
+	b[57] = flag
+	copy(b[58:], dut1)
+	return string(b) + "  0.0000100"
+}
+
+func TestUT1(t *testing.T) {
+	defer tai.ClearDUT1()
+	finals := strings.Join([]string{
+		finalsLine("161230", "57752.00", 'I', "-0.5894680"),
+		finalsLine("161231", "57753.00", 'I', "-0.5906560"),
+		// a leap second ended 2016
+		finalsLine("17 1 1", "57754.00", 'I', " 0.4081970"),
+		finalsLine("17 1 2", "57755.00", 'P', " 0.4070000"),
+		"17 1 3 57756.00",
+	}, "\n")
+	values, err := tai.ParseFinals(strings.NewReader(finals))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 4 {
+		t.Fatalf("expected 4 values, got %d", len(values))
+	}
+	if v := values[3]; v.MJD != 57755 || !v.Predicted || !v.UT1MinusUTC.Eq(tai.Dur(0, 407e15)) {
+		t.Fatalf("expected a predicted value of 0.407 s on MJD 57755, got %+v", v)
+	}
+	tai.RegisterDUT1(values...)
+
+	midnight := tai.FromTime(time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC))
+	if d, err := tai.DUT1At(midnight); err != nil || !d.Eq(tai.Dur(0, -5906560e11)) {
+		t.Fatalf("expected the tabulated value at midnight, got %v, %v", d, err)
+	}
+	// UT1-TAI is interpolated, so UT1-UTC is continuous across the leap second
+	// but for its step
+	before := tai.FromTime(time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC))
+	after := tai.FromTime(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC))
+	db, _ := tai.DUT1At(before)
+	da, _ := tai.DUT1At(after)
+	if step := da.Sub(db); step.Less(tai.Dur(0, 999e15)) || tai.Dur(1, 1e15).Less(step) {
+		t.Fatalf("expected UT1-UTC to step by a second at the leap, got %v", step)
+	}
+	noon := tai.FromTime(time.Date(2016, 12, 30, 12, 0, 0, 0, time.UTC))
+	ut1, err := noon.UT1()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// UT1-UTC is halfway between -0.5894680 and -0.5906560
+	exp := tai.Dur(1483099200, 0).Add(tai.Dur(0, -5900620e11))
+	if !ut1.Eq(exp) {
+		t.Fatalf("expected %v, got %v", exp, ut1)
+	}
+	back, err := tai.FromUT1(ut1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !back.Eq(noon) {
+		t.Fatalf("expected %+v, got %+v", noon, back)
+	}
+	if _, err := midnight.Add(-2*tai.Day, 0).UT1(); err != tai.ErrNoDUT1 {
+		t.Fatalf("expected ErrNoDUT1 before the table, got %v", err)
+	}
+	if _, err := midnight.Add(3*tai.Day, 0).UT1(); err != tai.ErrNoDUT1 {
+		t.Fatalf("expected ErrNoDUT1 after the table, got %v", err)
+	}
+	tai.RegisterDUT1(tai.DUT1{MJD: 57755, UT1MinusUTC: tai.Dur(0, 4e17)})
+	if table := tai.DUT1Table(); len(table) != 4 || !table[3].UT1MinusUTC.Eq(tai.Dur(0, 4e17)) {
+		t.Fatalf("expected the value to be replaced, got %+v", table)
+	}
+}
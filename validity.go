@@ -0,0 +1,56 @@
+package tai
+
+import "fmt"
+
+// Validity is the outcome of checking a ValidityWindow
+type Validity int
+
+const (
+	// Indeterminate means the clock is too uncertain to decide: the moment
+	// may be inside or outside the window
+	Indeterminate Validity = iota
+	// Valid means the moment is certainly within the window
+	Valid
+	// NotYetValid means the moment is certainly before NotBefore
+	NotYetValid
+	// Expired means the moment is certainly after NotAfter
+	Expired
+)
+
+var validityNames = [...]string{"Indeterminate", "Valid", "NotYetValid", "Expired"}
+
+// String returns the name of v, e.g. Expired
+func (v Validity) String() string {
+	if v < Indeterminate || v > Expired {
+		return fmt.Sprintf("Validity(%d)", int(v))
+	}
+	return validityNames[v]
+}
+
+// ValidityWindow is the span of time in which a certificate, token, or other
+// credential is valid, from NotBefore to NotAfter inclusive, as in X.509
+type ValidityWindow struct {
+	NotBefore, NotAfter TAI
+}
+
+// Contains returns true if t is within w
+func (w ValidityWindow) Contains(t TAI) bool {
+	return !t.Before(w.NotBefore) && !t.After(w.NotAfter)
+}
+
+// Check returns the validity of w at a moment known only to within now, such
+// as the reading of func NowBounded.  A credential is only Valid if it is
+// valid at every moment now may be, and is only NotYetValid or Expired if it
+// is invalid at every moment now may be; otherwise the validity is
+// Indeterminate.
+func (w ValidityWindow) Check(now BoundedTAI) Validity {
+	switch {
+	case now.Latest.Before(w.NotBefore):
+		return NotYetValid
+	case now.Earliest.After(w.NotAfter):
+		return Expired
+	case w.Contains(now.Earliest) && w.Contains(now.Latest):
+		return Valid
+	}
+	return Indeterminate
+}
@@ -0,0 +1,34 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestValidityWindowCheck(t *testing.T) {
+	nb := tai.Date(2024, 1, 1)
+	na := tai.Date(2025, 1, 1)
+	w := tai.ValidityWindow{NotBefore: nb, NotAfter: na}
+	radius := tai.Dur(2, 0)
+	cases := []struct {
+		descr string
+		now   tai.BoundedTAI
+		exp   tai.Validity
+	}{
+		{"Inside", tai.Bound(tai.Date(2024, 7, 1), radius), tai.Valid},
+		{"ExactBounds", tai.BoundedTAI{Earliest: nb, Latest: na}, tai.Valid},
+		{"Before", tai.Bound(nb.Add(-3, 0), radius), tai.NotYetValid},
+		{"After", tai.Bound(na.Add(3, 0), radius), tai.Expired},
+		{"StraddlesNotBefore", tai.Bound(nb.Add(1, 0), radius), tai.Indeterminate},
+		{"StraddlesNotAfter", tai.Bound(na, radius), tai.Indeterminate},
+		{"CoversWindow", tai.Bound(tai.Date(2024, 7, 1), tai.Dur(tai.Year, 0)), tai.Indeterminate},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if got := w.Check(tc.now); got != tc.exp {
+				t.Fatalf("expected %v, got %v", tc.exp, got)
+			}
+		})
+	}
+}
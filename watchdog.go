@@ -0,0 +1,83 @@
+package tai
+
+import "sync"
+
+// Watchdog detects a stalled process which fails to Feed it within a timeout.
+//
+// the watchdog is armed by the first call to Feed.  Afterwards, it is expired
+// once the timeout elapses without another Feed.  If an expiry callback is
+// provided, it is called once per expiry using a Timer.
+//
+// Watchdog is safe for concurrent use.
+type Watchdog struct {
+	mu       sync.Mutex
+	timeout  Duration
+	deadline TAI
+	armed    bool
+	onExpire func()
+	timer    *Timer
+}
+
+// NewWatchdog returns a Watchdog with the given timeout.  onExpire may be nil,
+// in which case expiry is only reported by Expired.
+func NewWatchdog(timeout Duration, onExpire func()) *Watchdog {
+	return &Watchdog{timeout: timeout, onExpire: onExpire}
+}
+
+// Feed signals that the process is alive at now, postponing expiry until now
+// plus the timeout
+func (w *Watchdog) Feed(now TAI) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.deadline = now.AddDuration(w.timeout)
+	w.armed = true
+	if w.onExpire == nil {
+		return
+	}
+	if w.timer == nil {
+		w.timer = AfterFunc(w.deadline, w.fire)
+		return
+	}
+	w.timer.Reset(w.deadline)
+}
+
+// Expired returns true if the watchdog is armed and now is at or after the
+// deadline
+func (w *Watchdog) Expired(now TAI) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.armed && !now.Before(w.deadline)
+}
+
+// Deadline returns the moment at which the watchdog expires, and false if it
+// is not armed
+func (w *Watchdog) Deadline() (TAI, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.deadline, w.armed
+}
+
+// Stop disarms the watchdog; it may be rearmed with Feed
+func (w *Watchdog) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.armed = false
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+}
+
+func (w *Watchdog) fire() {
+	w.mu.Lock()
+	// a Feed may have raced with the timer, or Stop been called
+	expired := w.armed && !DefaultClock.Now().Before(w.deadline)
+	if w.armed && !expired {
+		// the host clock was stepped while waiting
+		w.timer.Reset(w.deadline)
+	}
+	f := w.onExpire
+	w.mu.Unlock()
+	if expired {
+		f()
+	}
+}
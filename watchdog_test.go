@@ -0,0 +1,42 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestWatchdogExpired(t *testing.T) {
+	w := tai.NewWatchdog(tai.Dur(1, 0), nil)
+	now := tai.Date(2024, 7, 1)
+	if w.Expired(now) {
+		t.Fatal("unarmed watchdog reported expiry")
+	}
+	w.Feed(now)
+	if w.Expired(now.Add(0, 1e18-1)) {
+		t.Fatal("watchdog expired before the timeout")
+	}
+	if !w.Expired(now.AddHMS(0, 0, 1)) {
+		t.Fatal("watchdog did not expire at the timeout")
+	}
+	w.Feed(now.AddHMS(0, 0, 1))
+	if w.Expired(now.AddHMS(0, 0, 1)) {
+		t.Fatal("feeding did not postpone expiry")
+	}
+	w.Stop()
+	if w.Expired(now.AddHMS(1, 0, 0)) {
+		t.Fatal("stopped watchdog reported expiry")
+	}
+}
+
+func TestWatchdogCallback(t *testing.T) {
+	expired := make(chan struct{}, 1)
+	w := tai.NewWatchdog(tai.Dur(0, 20*tai.Millisecond), func() { expired <- struct{}{} })
+	w.Feed(tai.Now())
+	select {
+	case <-expired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expiry callback was not called")
+	}
+}
@@ -0,0 +1,64 @@
+package tai
+
+// Weekday returns the day of the week of t in the TAI calendar
+func (t TAI) Weekday() Weekday {
+	days, _ := floorDiv(t.sec, Day)
+	return Weekday(weekdayFromDays64(days))
+}
+
+// NextWeekdayInstant returns the instant at the same time of day as t on the
+// next day after t that falls on weekday, between one and seven days later.
+func NextWeekdayInstant(t TAI, weekday Weekday) TAI {
+	ahead := mod7(int(weekday - t.Weekday()))
+	if ahead == 0 {
+		ahead = 7
+	}
+	t.sec += int64(ahead) * Day
+	return t
+}
+
+// PreviousWeekdayInstant returns the instant at the same time of day as t on
+// the last day before t that fell on weekday, between one and seven days
+// earlier.
+func PreviousWeekdayInstant(t TAI, weekday Weekday) TAI {
+	behind := mod7(int(t.Weekday() - weekday))
+	if behind == 0 {
+		behind = 7
+	}
+	t.sec -= int64(behind) * Day
+	return t
+}
+
+// NthWeekdayOfMonthInstant returns the start of the nth occurrence of weekday
+// in the given month, e.g. n=2 and weekday=Sunday is the second Sunday.
+// Negative n counts from the end of the month; n=-1 is the last such weekday.
+//
+// if the month does not have an nth such weekday, or n is zero, false is
+// returned.
+func NthWeekdayOfMonthInstant(year, month, n int, weekday Weekday) (TAI, bool) {
+	if n == 0 || month < 1 || month > 12 {
+		return TAI{}, false
+	}
+	first := DaysFromCivil64(year, month, 1)
+	dim := int64(DaysInMonth(month, year))
+	var d int64
+	if n > 0 {
+		d = first + int64(mod7(int(weekday)-weekdayFromDays64(first))+7*(n-1))
+	} else {
+		last := first + dim - 1
+		d = last - int64(mod7(weekdayFromDays64(last)-int(weekday))-7*(n+1))
+	}
+	if d < first || d >= first+dim {
+		return TAI{}, false
+	}
+	return TAI{sec: SecsEpochFromDays64(d)}, true
+}
+
+// mod7 returns x modulo 7 in [0, 6]
+func mod7(x int) int {
+	x %= 7
+	if x < 0 {
+		x += 7
+	}
+	return x
+}
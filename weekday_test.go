@@ -0,0 +1,87 @@
+package tai_test
+
+import (
+	"testing"
+
+	"github.com/brandondube/tai"
+)
+
+func TestNextPreviousWeekdayInstant(t *testing.T) {
+	// Monday, July 1, 2024
+	mon := tai.Date(2024, 7, 1).AddHMS(13, 45, 0).Add(0, 5)
+	cases := []struct {
+		descr string
+		got   tai.TAI
+		exp   tai.TAI
+	}{
+		{"NextFriday", tai.NextWeekdayInstant(mon, tai.Friday), tai.Date(2024, 7, 5).AddHMS(13, 45, 0).Add(0, 5)},
+		{"NextMondayIsAWeekAhead", tai.NextWeekdayInstant(mon, tai.Monday), tai.Date(2024, 7, 8).AddHMS(13, 45, 0).Add(0, 5)},
+		{"NextSunday", tai.NextWeekdayInstant(mon, tai.Sunday), tai.Date(2024, 7, 7).AddHMS(13, 45, 0).Add(0, 5)},
+		{"PreviousSunday", tai.PreviousWeekdayInstant(mon, tai.Sunday), tai.Date(2024, 6, 30).AddHMS(13, 45, 0).Add(0, 5)},
+		{"PreviousMondayIsAWeekBehind", tai.PreviousWeekdayInstant(mon, tai.Monday), tai.Date(2024, 6, 24).AddHMS(13, 45, 0).Add(0, 5)},
+		{"BeforeEpoch", tai.NextWeekdayInstant(tai.Date(1957, 12, 31), tai.Wednesday), tai.Date(1958, 1, 1)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			if !tc.got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp.AsGregorian(), tc.got.AsGregorian())
+			}
+		})
+	}
+}
+
+func TestNthWeekdayOfMonthInstant(t *testing.T) {
+	cases := []struct {
+		descr string
+		y, m  int
+		n     int
+		wd    tai.Weekday
+		exp   tai.TAI
+		expOk bool
+	}{
+		{"SecondSunday", 2024, tai.March, 2, tai.Sunday, tai.Date(2024, 3, 10), true},
+		{"FirstMonday", 2024, tai.July, 1, tai.Monday, tai.Date(2024, 7, 1), true},
+		{"LastFriday", 2024, tai.July, -1, tai.Friday, tai.Date(2024, 7, 26), true},
+		{"LastWednesday", 2024, tai.July, -1, tai.Wednesday, tai.Date(2024, 7, 31), true},
+		{"FifthThursdayOfFeb2024", 2024, tai.February, 5, tai.Thursday, tai.Date(2024, 2, 29), true},
+		{"NoFifthFriday", 2024, tai.February, 5, tai.Friday, tai.TAI{}, false},
+		{"ZeroN", 2024, tai.February, 0, tai.Friday, tai.TAI{}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			got, ok := tai.NthWeekdayOfMonthInstant(tc.y, tc.m, tc.n, tc.wd)
+			if ok != tc.expOk {
+				t.Fatalf("expected ok=%v, got %v", tc.expOk, ok)
+			}
+			if !got.Eq(tc.exp) {
+				t.Fatalf("expected %+v, got %+v", tc.exp.AsGregorian(), got.AsGregorian())
+			}
+		})
+	}
+}
+
+func TestWeekdayConstantsMatchCalendar(t *testing.T) {
+	// July 1, 2024 was a Monday
+	for i, wd := range []tai.Weekday{tai.Monday, tai.Tuesday, tai.Wednesday, tai.Thursday, tai.Friday, tai.Saturday, tai.Sunday} {
+		ta := tai.Date(2024, 7, 1+i)
+		if got := ta.Weekday(); got != wd {
+			t.Errorf("July %d, 2024: expected %v, got %v", 1+i, wd, got)
+		}
+		g := ta.AsGregorian()
+		if got := tai.WeekdayFromDays(tai.DaysFromCivil(g.Year, int(g.Month), g.Day)); got != int(wd) {
+			t.Errorf("July %d, 2024: WeekdayFromDays disagrees with %v, got %d", 1+i, wd, got)
+		}
+	}
+}
+
+func TestWeekdayString(t *testing.T) {
+	if s := tai.Monday.String(); s != "Monday" {
+		t.Fatalf("expected Monday, got %s", s)
+	}
+	if s := tai.Saturday.Next().String(); s != "Sunday" {
+		t.Fatalf("expected Sunday, got %s", s)
+	}
+	if s := tai.Weekday(9).String(); s != "Weekday(9)" {
+		t.Fatalf("expected Weekday(9), got %s", s)
+	}
+}
@@ -0,0 +1,150 @@
+package tai
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrX509Range is returned when formatting an instant outside of the years of
+// an ASN.1 time: 1950 through 2049 for UTCTime, and 0 through 9999 for
+// GeneralizedTime
+var ErrX509Range = errors.New("tai: time out of range of the ASN.1 time")
+
+// UTCTime returns t as an ASN.1 UTCTime in the DER form required by RFC 5280,
+// YYMMDDHHMMSSZ, e.g. 240701123456Z.  ErrX509Range is returned outside of 1950
+// through 2049, which RFC 5280 encodes as GeneralizedTime; see func X509Time.
+//
+// the time is truncated toward the past to seconds.  Unlike Unix, a time
+// within a leap second is formatted as the 60th second of the minute.
+func (t TAI) UTCTime() (string, error) {
+	y, mo, d, h, mi, s := t.x509Civil()
+	if y < 1950 || y > 2049 {
+		return "", ErrX509Range
+	}
+	return string(appendX509(make([]byte, 0, 13), y%100, 2, mo, d, h, mi, s)), nil
+}
+
+// GeneralizedTime returns t as an ASN.1 GeneralizedTime in the DER form
+// required by RFC 5280, YYYYMMDDHHMMSSZ without fractional seconds, e.g.
+// 20500101000000Z.  ErrX509Range is returned outside of the years 0 through
+// 9999.  Leap seconds are formatted as by func UTCTime.
+func (t TAI) GeneralizedTime() (string, error) {
+	y, mo, d, h, mi, s := t.x509Civil()
+	if y < 0 || y > 9999 {
+		return "", ErrX509Range
+	}
+	return string(appendX509(make([]byte, 0, 15), y, 4, mo, d, h, mi, s)), nil
+}
+
+// X509Time returns t as the validity time of an X.509 certificate, which RFC
+// 5280 requires be a UTCTime through 2049 and a GeneralizedTime from 2050,
+// and reports whether it is a GeneralizedTime
+func (t TAI) X509Time() (s string, generalized bool, err error) {
+	if s, err := t.UTCTime(); err == nil {
+		return s, false, nil
+	}
+	s, err = t.GeneralizedTime()
+	return s, true, err
+}
+
+// x509Civil returns the UTC civil time of t, with the second of a leap second
+// as 60
+func (t TAI) x509Civil() (y, mo, d, h, mi, s int) {
+	secs, _ := t.unix()
+	if inLeapSecond(t.sec - unixEpochSkew) {
+		// Unix counts the leap second as the following second
+		y, mo, d, h, mi, _ = civilFromUnix(secs - 1)
+		return y, mo, d, h, mi, 60
+	}
+	return civilFromUnix(secs)
+}
+
+func appendX509(b []byte, y, width, mo, d, h, mi, s int) []byte {
+	b = appendInt(b, int64(y), width)
+	for _, v := range [...]int{mo, d, h, mi, s} {
+		b = appendInt(b, int64(v), 2)
+	}
+	return append(b, 'Z')
+}
+
+// ParseUTCTime parses an ASN.1 UTCTime in the form of RFC 5280, YYMMDDHHMMSSZ.
+// Years 50 through 99 are 1950 through 1999, and 00 through 49 are 2000
+// through 2049.  The 60th second of a minute is accepted where a positive leap
+// second may occur, as by ValidateRFC3339, and is converted to the leap second.
+func ParseUTCTime(s string) (TAI, error) {
+	t, err := parseX509(s, 2)
+	if err != nil {
+		return TAI{}, fmt.Errorf("ParseUTCTime: %w", err)
+	}
+	return t, nil
+}
+
+// ParseGeneralizedTime parses an ASN.1 GeneralizedTime in the form of RFC
+// 5280, YYYYMMDDHHMMSSZ without fractional seconds.  Leap seconds are
+// accepted as by ParseUTCTime.
+func ParseGeneralizedTime(s string) (TAI, error) {
+	t, err := parseX509(s, 4)
+	if err != nil {
+		return TAI{}, fmt.Errorf("ParseGeneralizedTime: %w", err)
+	}
+	return t, nil
+}
+
+// ParseX509Time parses the validity time of an X.509 certificate, either a
+// UTCTime or a GeneralizedTime as told by its length
+func ParseX509Time(s string) (TAI, error) {
+	if len(s) == len("YYMMDDHHMMSSZ") {
+		return ParseUTCTime(s)
+	}
+	return ParseGeneralizedTime(s)
+}
+
+// ParseX509Validity returns the ValidityWindow of the notBefore and notAfter
+// times of an X.509 certificate; see func ParseX509Time
+func ParseX509Validity(notBefore, notAfter string) (ValidityWindow, error) {
+	nb, err := ParseX509Time(notBefore)
+	if err != nil {
+		return ValidityWindow{}, err
+	}
+	na, err := ParseX509Time(notAfter)
+	if err != nil {
+		return ValidityWindow{}, err
+	}
+	return ValidityWindow{NotBefore: nb, NotAfter: na}, nil
+}
+
+// parseX509 parses a UTCTime or GeneralizedTime with a year of width digits
+func parseX509(s string, width int) (TAI, error) {
+	p := parser{s: s}
+	y := p.digits(width)
+	if width == 2 {
+		if y < 50 {
+			y += 2000
+		} else {
+			y += 1900
+		}
+	}
+	mo, d, h, mi, sec := p.digits(2), p.digits(2), p.digits(2), p.digits(2), p.digits(2)
+	p.expect('Z')
+	if p.err == nil && p.i != len(s) {
+		p.fail("unexpected trailing characters")
+	}
+	if p.err != nil {
+		return TAI{}, p.err
+	}
+	if err := validCivil(y, mo, d, h, mi, sec); err != nil {
+		return TAI{}, err
+	}
+	unix := unixFromCivil(y, mo, d, h, mi, sec)
+	if sec == 60 {
+		// unix is the end of the UTC day, which the leap second precedes
+		if !possibleLeapSecond(unix) {
+			return TAI{}, errors.New("leap second at a time without one")
+		}
+		return Unix(unix, 0).Add(-1, 0), nil
+	}
+	if inSkippedSecond(unix) {
+		return TAI{}, errors.New("time omitted by a negative leap second")
+	}
+	return Unix(unix, 0), nil
+}
@@ -0,0 +1,102 @@
+package tai_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brandondube/tai"
+)
+
+func TestX509Time(t *testing.T) {
+	cases := []struct {
+		descr       string
+		in          time.Time
+		exp         string
+		generalized bool
+	}{
+		{"1950", time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC), "500101000000Z", false},
+		{"1999", time.Date(1999, 12, 31, 23, 59, 59, 999999999, time.UTC), "991231235959Z", false},
+		{"2024", time.Date(2024, 7, 1, 12, 34, 56, 0, time.UTC), "240701123456Z", false},
+		{"2049", time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC), "491231235959Z", false},
+		{"2050", time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC), "20500101000000Z", true},
+		{"1949", time.Date(1949, 12, 31, 23, 59, 59, 0, time.UTC), "19491231235959Z", true},
+		{"Forever", time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC), "99991231235959Z", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.descr, func(t *testing.T) {
+			ta := tai.FromTime(tc.in)
+			s, generalized, err := ta.X509Time()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != tc.exp || generalized != tc.generalized {
+				t.Fatalf("expected %s %v, got %s %v", tc.exp, tc.generalized, s, generalized)
+			}
+			back, err := tai.ParseX509Time(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := tai.FromTime(tc.in.Truncate(time.Second)); !back.Eq(exp) {
+				t.Fatalf("expected %v, got %v", exp.AsTime(), back.AsTime())
+			}
+		})
+	}
+}
+
+func TestX509Range(t *testing.T) {
+	if _, err := tai.FromTime(time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)).UTCTime(); err != tai.ErrX509Range {
+		t.Fatalf("expected ErrX509Range, got %v", err)
+	}
+	if _, err := tai.FromTime(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)).GeneralizedTime(); err != tai.ErrX509Range {
+		t.Fatalf("expected ErrX509Range, got %v", err)
+	}
+}
+
+func TestX509LeapSecond(t *testing.T) {
+	leap := tai.FromTime(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)).Add(-1, 0)
+	s, err := leap.UTCTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "161231235960Z"; s != exp {
+		t.Fatalf("expected %s, got %s", exp, s)
+	}
+	got, err := tai.ParseGeneralizedTime("20161231235960Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eq(leap) {
+		t.Fatalf("expected the leap second, got %v", got)
+	}
+}
+
+func TestParseX509Invalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"2407011234Z",
+		"240701123456",
+		"240701123456+0000",
+		"240701123456Z ",
+		"20240701123456.5Z",
+		"240230000000Z",
+		"240701123460Z",
+		"20240630235960Z",
+	} {
+		if _, err := tai.ParseX509Time(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
+
+func TestParseX509Validity(t *testing.T) {
+	w, err := tai.ParseX509Validity("240101000000Z", "20500101000000Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !w.Contains(tai.FromTime(time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC))) {
+		t.Fatal("expected the window to contain 2049")
+	}
+	if w.Contains(tai.FromTime(time.Date(2050, 1, 1, 0, 0, 1, 0, time.UTC))) {
+		t.Fatal("expected the window to end at 2050")
+	}
+}